@@ -0,0 +1,342 @@
+package check
+
+// AllEqual checks if all elements of a slice of any comparable type have the same value.
+// For a slice with no or one element, it returns true.
+func AllEqual[T comparable](Slice []T) bool {
+	for i := 1; i < len(Slice); i++ {
+		if Slice[i] != Slice[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// AllEqualTol checks if all elements of a slice of any float type have the same value.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The first element is the reference value, so the function checks if all elements are within the tolerance of it.
+// For a slice with no or one element, it returns true.
+func AllEqualTol[T Float](Slice []T, Tol Tolerance) bool {
+	for i := 1; i < len(Slice); i++ {
+		if !isWithinTolerance(Slice[i], Slice[0], Tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllEqualFloat checks if all elements of a slice of any float type have the same value.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The first element is the reference value, so the function checks if all elements are within Epsilon of it.
+// For a slice with no or one element, it returns true.
+func AllEqualFloat[T Float](Slice []T, Epsilon float64) bool {
+	return AllEqualTol(Slice, AbsTolerance(Epsilon))
+}
+
+// AllEqualMap checks if all values of a map of any comparable key and value types are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMap[K comparable, V comparable](Map map[K]V) bool {
+	var first V
+	checked := false
+	for _, value := range Map {
+		if !checked {
+			first, checked = value, true
+			continue
+		}
+		if value != first {
+			return false
+		}
+	}
+	return true
+}
+
+// AllEqualMapTol checks if all values of a map of any ordered key type and any float value type are the same.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The value of the smallest key is the reference value, so the function checks if all values are within the tolerance of it.
+// For a map with no or one key, it returns true.
+func AllEqualMapTol[K Ordered, V Float](Map map[K]V, Tol Tolerance) bool {
+	return AllEqualTol(valuesBySortedKeys(Map), Tol)
+}
+
+// AllEqualMapFloat checks if all values of a map of any ordered key type and any float value type are the same.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The value of the smallest key is the reference value, so the function checks if all values are within Epsilon of it.
+// For a map with no or one key, it returns true.
+func AllEqualMapFloat[K Ordered, V Float](Map map[K]V, Epsilon float64) bool {
+	return AllEqualMapTol(Map, AbsTolerance(Epsilon))
+}
+
+// WhichNotEqual checks which elements of a slice of any comparable type break the majority value,
+// that is, the value that occurs most often in the slice (in case of a tie, the one that occurs first).
+// Returns a tuple of indices, true/false, where indices are the indices of the elements different from the majority value
+// and true means that there is at least one such element.
+func WhichNotEqual[T comparable](Slice []T) ([]int, bool) {
+	counts := make(map[T]int, len(Slice))
+	for _, value := range Slice {
+		counts[value]++
+	}
+	var majority T
+	majorityCount := 0
+	for _, value := range Slice {
+		if counts[value] > majorityCount {
+			majority, majorityCount = value, counts[value]
+		}
+	}
+	indices := make([]int, 0)
+	for i, value := range Slice {
+		if value != majority {
+			indices = append(indices, i)
+		}
+	}
+	return indices, len(indices) > 0
+}
+
+// WhichNotEqualTol checks which elements of a slice of any float type break the majority value,
+// that is, the element with the most elements within the tolerance of it (in case of a tie, the one that occurs first).
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Returns a tuple of indices, true/false, where indices are the indices of the elements not within the tolerance of the majority value
+// and true means that there is at least one such element.
+// Since the tolerance is not transitive, no such elements does not mean that AllEqualTol returns true.
+// The function compares each pair of elements, so it runs in O(n²) time.
+func WhichNotEqualTol[T Float](Slice []T, Tol Tolerance) ([]int, bool) {
+	majority, majorityCount := 0, -1
+	for i, reference := range Slice {
+		count := 0
+		for _, value := range Slice {
+			if isWithinTolerance(value, reference, Tol) {
+				count++
+			}
+		}
+		if count > majorityCount {
+			majority, majorityCount = i, count
+		}
+	}
+	indices := make([]int, 0)
+	for i, value := range Slice {
+		if !isWithinTolerance(value, Slice[majority], Tol) {
+			indices = append(indices, i)
+		}
+	}
+	return indices, len(indices) > 0
+}
+
+// WhichNotEqualFloat checks which elements of a slice of any float type break the majority value
+// (see WhichNotEqualTol). The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of indices, true/false, where indices are the indices of the elements not within Epsilon of the majority value
+// and true means that there is at least one such element.
+func WhichNotEqualFloat[T Float](Slice []T, Epsilon float64) ([]int, bool) {
+	return WhichNotEqualTol(Slice, AbsTolerance(Epsilon))
+}
+
+// WhichNotEqualMap checks which keys of a map of any ordered key type and any comparable value type break the majority value,
+// that is, the value that occurs most often in the map (in case of a tie, the one of the smallest key).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMap[K Ordered, V comparable](Map map[K]V) ([]K, bool) {
+	keys := SortedKeys(Map)
+	indices, exists := WhichNotEqual(valuesOf(Map, keys))
+	return keysAt(keys, indices), exists
+}
+
+// WhichNotEqualMapTol checks which keys of a map of any ordered key type and any float value type break the majority value
+// (see WhichNotEqualTol; in case of a tie, the value of the smallest key is used).
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are not within the tolerance of the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapTol[K Ordered, V Float](Map map[K]V, Tol Tolerance) ([]K, bool) {
+	keys := SortedKeys(Map)
+	indices, exists := WhichNotEqualTol(valuesOf(Map, keys), Tol)
+	return keysAt(keys, indices), exists
+}
+
+// WhichNotEqualMapFloat checks which keys of a map of any ordered key type and any float value type break the majority value
+// (see WhichNotEqualMapTol). The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are not within Epsilon of the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapFloat[K Ordered, V Float](Map map[K]V, Epsilon float64) ([]K, bool) {
+	return WhichNotEqualMapTol(Map, AbsTolerance(Epsilon))
+}
+
+// AllEqualIntSlice checks if all elements of an int slice have the same value.
+// For a slice with no or one element, it returns true.
+func AllEqualIntSlice(Slice []int) bool {
+	return AllEqual(Slice)
+}
+
+// WhichNotEqualIntSlice checks which elements of an int slice break the majority value (see WhichNotEqual).
+// Returns a tuple of indices, true/false, where indices are the indices of the elements different from the majority value
+// and true means that there is at least one such element.
+func WhichNotEqualIntSlice(Slice []int) ([]int, bool) {
+	return WhichNotEqual(Slice)
+}
+
+// AllEqualStringSlice checks if all elements of a string slice have the same value.
+// For a slice with no or one element, it returns true.
+func AllEqualStringSlice(Slice []string) bool {
+	return AllEqual(Slice)
+}
+
+// WhichNotEqualStringSlice checks which elements of a string slice break the majority value (see WhichNotEqual).
+// Returns a tuple of indices, true/false, where indices are the indices of the elements different from the majority value
+// and true means that there is at least one such element.
+func WhichNotEqualStringSlice(Slice []string) ([]int, bool) {
+	return WhichNotEqual(Slice)
+}
+
+// AllEqualFloat64Slice checks if all elements of a float64 slice have the same value.
+// The Epsilon parameter sets the accuracy of the comparison of two floats; the first element is the reference value.
+// For a slice with no or one element, it returns true.
+func AllEqualFloat64Slice(Slice []float64, Epsilon float64) bool {
+	return AllEqualFloat(Slice, Epsilon)
+}
+
+// WhichNotEqualFloat64Slice checks which elements of a float64 slice break the majority value (see WhichNotEqualTol).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of indices, true/false, where indices are the indices of the elements not within Epsilon of the majority value
+// and true means that there is at least one such element.
+func WhichNotEqualFloat64Slice(Slice []float64, Epsilon float64) ([]int, bool) {
+	return WhichNotEqualFloat(Slice, Epsilon)
+}
+
+// AllEqualBoolSlice checks if all elements of a bool slice have the same value.
+// For a slice with no or one element, it returns true.
+func AllEqualBoolSlice(Slice []bool) bool {
+	return AllEqual(Slice)
+}
+
+// WhichNotEqualBoolSlice checks which elements of a bool slice break the majority value (see WhichNotEqual).
+// Returns a tuple of indices, true/false, where indices are the indices of the elements different from the majority value
+// and true means that there is at least one such element.
+func WhichNotEqualBoolSlice(Slice []bool) ([]int, bool) {
+	return WhichNotEqual(Slice)
+}
+
+// AllEqualMapStringString checks if all values of map[string]string are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMapStringString(Map map[string]string) bool {
+	return AllEqualMap(Map)
+}
+
+// WhichNotEqualMapStringString checks which keys of map[string]string break the majority value (see WhichNotEqualMap).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapStringString(Map map[string]string) ([]string, bool) {
+	return WhichNotEqualMap(Map)
+}
+
+// AllEqualMapStringInt checks if all values of map[string]int are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMapStringInt(Map map[string]int) bool {
+	return AllEqualMap(Map)
+}
+
+// WhichNotEqualMapStringInt checks which keys of map[string]int break the majority value (see WhichNotEqualMap).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapStringInt(Map map[string]int) ([]string, bool) {
+	return WhichNotEqualMap(Map)
+}
+
+// AllEqualMapStringFloat64 checks if all values of map[string]float64 are the same.
+// The Epsilon parameter sets the accuracy of the comparison of two floats; the value of the smallest key is the reference value.
+// For a map with no or one key, it returns true.
+func AllEqualMapStringFloat64(Map map[string]float64, Epsilon float64) bool {
+	return AllEqualMapFloat(Map, Epsilon)
+}
+
+// WhichNotEqualMapStringFloat64 checks which keys of map[string]float64 break the majority value (see WhichNotEqualMapTol).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are not within Epsilon of the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapStringFloat64(Map map[string]float64, Epsilon float64) ([]string, bool) {
+	return WhichNotEqualMapFloat(Map, Epsilon)
+}
+
+// AllEqualMapStringBool checks if all values of map[string]bool are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMapStringBool(Map map[string]bool) bool {
+	return AllEqualMap(Map)
+}
+
+// WhichNotEqualMapStringBool checks which keys of map[string]bool break the majority value (see WhichNotEqualMap).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapStringBool(Map map[string]bool) ([]string, bool) {
+	return WhichNotEqualMap(Map)
+}
+
+// AllEqualMapIntString checks if all values of map[int]string are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMapIntString(Map map[int]string) bool {
+	return AllEqualMap(Map)
+}
+
+// WhichNotEqualMapIntString checks which keys of map[int]string break the majority value (see WhichNotEqualMap).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapIntString(Map map[int]string) ([]int, bool) {
+	return WhichNotEqualMap(Map)
+}
+
+// AllEqualMapIntInt checks if all values of map[int]int are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMapIntInt(Map map[int]int) bool {
+	return AllEqualMap(Map)
+}
+
+// WhichNotEqualMapIntInt checks which keys of map[int]int break the majority value (see WhichNotEqualMap).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapIntInt(Map map[int]int) ([]int, bool) {
+	return WhichNotEqualMap(Map)
+}
+
+// AllEqualMapIntFloat64 checks if all values of map[int]float64 are the same.
+// The Epsilon parameter sets the accuracy of the comparison of two floats; the value of the smallest key is the reference value.
+// For a map with no or one key, it returns true.
+func AllEqualMapIntFloat64(Map map[int]float64, Epsilon float64) bool {
+	return AllEqualMapFloat(Map, Epsilon)
+}
+
+// WhichNotEqualMapIntFloat64 checks which keys of map[int]float64 break the majority value (see WhichNotEqualMapTol).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are not within Epsilon of the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapIntFloat64(Map map[int]float64, Epsilon float64) ([]int, bool) {
+	return WhichNotEqualMapFloat(Map, Epsilon)
+}
+
+// AllEqualMapIntBool checks if all values of map[int]bool are the same.
+// For a map with no or one key, it returns true.
+func AllEqualMapIntBool(Map map[int]bool) bool {
+	return AllEqualMap(Map)
+}
+
+// WhichNotEqualMapIntBool checks which keys of map[int]bool break the majority value (see WhichNotEqualMap).
+// Returns a tuple of keys, true/false, where keys are the sorted keys whose values are different from the majority value
+// and true means that there is at least one such key.
+func WhichNotEqualMapIntBool(Map map[int]bool) ([]int, bool) {
+	return WhichNotEqualMap(Map)
+}
+
+// valuesOf returns the values of a map for the keys, in the same order.
+func valuesOf[K comparable, V any](Map map[K]V, Keys []K) []V {
+	values := make([]V, len(Keys))
+	for i, key := range Keys {
+		values[i] = Map[key]
+	}
+	return values
+}
+
+// valuesBySortedKeys returns the values of a map ordered by their keys.
+func valuesBySortedKeys[K Ordered, V any](Map map[K]V) []V {
+	return valuesOf(Map, SortedKeys(Map))
+}
+
+// keysAt returns the keys at the indices.
+func keysAt[K any](Keys []K, Indices []int) []K {
+	selected := make([]K, len(Indices))
+	for i, index := range Indices {
+		selected[i] = Keys[index]
+	}
+	return selected
+}
@@ -0,0 +1,271 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAllEqualIntSlice(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected bool
+	}{
+		{[]int{}, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 1}, true},
+		{[]int{1, 1, 2}, false},
+		{[]int{2, 1, 1}, false},
+		{[]int{0, 0}, true},
+	}
+	for _, test := range tests {
+		actual := AllEqualIntSlice(test.input)
+		if actual != test.expected {
+			t.Errorf("AllEqualIntSlice(%v) = %v; want %v", test.input, actual, test.expected)
+		}
+	}
+}
+
+func ExampleAllEqualIntSlice() {
+	fmt.Println(AllEqualIntSlice([]int{55, 55, 55}))
+	fmt.Println(AllEqualIntSlice([]int{55, 56, 55}))
+	// Output:
+	// true
+	// false
+}
+
+func TestAllEqualStringSlice(t *testing.T) {
+	tests := []struct {
+		input    []string
+		expected bool
+	}{
+		{[]string{}, true},
+		{[]string{"a"}, true},
+		{[]string{"a", "a"}, true},
+		{[]string{"a", "A"}, false},
+		{[]string{"", ""}, true},
+	}
+	for _, test := range tests {
+		actual := AllEqualStringSlice(test.input)
+		if actual != test.expected {
+			t.Errorf("AllEqualStringSlice(%v) = %v; want %v", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestAllEqualFloat64Slice(t *testing.T) {
+	tests := []struct {
+		input    []float64
+		epsilon  float64
+		expected bool
+	}{
+		{[]float64{}, 0, true},
+		{[]float64{.1}, 0, true},
+		{[]float64{.1, .1, .1}, 0, true},
+		{[]float64{.1, .11, .1}, 0, false},
+		{[]float64{.1, .11, .1}, .01, true},
+		{[]float64{.1, .105, .095}, .01, true},
+		{[]float64{.11, .1, .09}, .01, false},
+		{[]float64{math.NaN()}, 0, true},
+		{[]float64{math.NaN(), math.NaN()}, 0, false},
+	}
+	for _, test := range tests {
+		actual := AllEqualFloat64Slice(test.input, test.epsilon)
+		if actual != test.expected {
+			t.Errorf("AllEqualFloat64Slice(%v, %v) = %v; want %v", test.input, test.epsilon, actual, test.expected)
+		}
+	}
+}
+
+func ExampleAllEqualFloat64Slice() {
+	fmt.Println(AllEqualFloat64Slice([]float64{.1, .11, .1}, 0))
+	fmt.Println(AllEqualFloat64Slice([]float64{.1, .11, .1}, .01))
+	// Output:
+	// false
+	// true
+}
+
+func TestAllEqualBoolSlice(t *testing.T) {
+	tests := []struct {
+		input    []bool
+		expected bool
+	}{
+		{[]bool{}, true},
+		{[]bool{false}, true},
+		{[]bool{true, true}, true},
+		{[]bool{false, false}, true},
+		{[]bool{true, false}, false},
+	}
+	for _, test := range tests {
+		actual := AllEqualBoolSlice(test.input)
+		if actual != test.expected {
+			t.Errorf("AllEqualBoolSlice(%v) = %v; want %v", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestAllEqualMaps(t *testing.T) {
+	if !AllEqualMapStringString(map[string]string{}) {
+		t.Errorf("AllEqualMapStringString(map[]) should be true")
+	}
+	if !AllEqualMapStringString(map[string]string{"a": "x", "b": "x"}) {
+		t.Errorf("AllEqualMapStringString(map[a:x b:x]) should be true")
+	}
+	if AllEqualMapStringInt(map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("AllEqualMapStringInt(map[a:1 b:2]) should be false")
+	}
+	if !AllEqualMapStringFloat64(map[string]float64{"a": 1, "b": 1.05}, .1) {
+		t.Errorf("AllEqualMapStringFloat64(map[a:1 b:1.05], .1) should be true")
+	}
+	if AllEqualMapStringBool(map[string]bool{"a": true, "b": false}) {
+		t.Errorf("AllEqualMapStringBool(map[a:true b:false]) should be false")
+	}
+	if !AllEqualMapIntString(map[int]string{1: "x"}) {
+		t.Errorf("AllEqualMapIntString(map[1:x]) should be true")
+	}
+	if !AllEqualMapIntInt(map[int]int{1: 0, 2: 0, 3: 0}) {
+		t.Errorf("AllEqualMapIntInt(map[1:0 2:0 3:0]) should be true")
+	}
+	if AllEqualMapIntFloat64(map[int]float64{1: 1, 2: 1.05}, .01) {
+		t.Errorf("AllEqualMapIntFloat64(map[1:1 2:1.05], .01) should be false")
+	}
+	if !AllEqualMapIntBool(map[int]bool{1: false, 2: false}) {
+		t.Errorf("AllEqualMapIntBool(map[1:false 2:false]) should be true")
+	}
+}
+
+func ExampleAllEqualMapStringInt() {
+	fmt.Println(AllEqualMapStringInt(map[string]int{"a": 1, "b": 1}))
+	fmt.Println(AllEqualMapStringInt(map[string]int{"a": 1, "b": 2}))
+	// Output:
+	// true
+	// false
+}
+
+func TestWhichNotEqualIntSlice(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{1, 1, 1}, []int{}},
+		{[]int{1, 2, 1}, []int{1}},
+		{[]int{2, 1, 1}, []int{0}},
+		{[]int{2, 1, 1, 3, 2, 1}, []int{0, 3, 4}},
+		{[]int{1, 2, 2, 1}, []int{1, 2}},
+		{[]int{0, 1, 1}, []int{0}},
+	}
+	for _, test := range tests {
+		actual, exists := WhichNotEqualIntSlice(test.input)
+		if !AreEqualSlicesInt(actual, test.expected) || exists != (len(test.expected) > 0) {
+			t.Errorf("WhichNotEqualIntSlice(%v) = %v, %v; want %v, %v", test.input, actual, exists, test.expected, len(test.expected) > 0)
+		}
+	}
+}
+
+func ExampleWhichNotEqualIntSlice() {
+	fmt.Println(WhichNotEqualIntSlice([]int{1, 1, 2, 1, 3}))
+	fmt.Println(WhichNotEqualIntSlice([]int{1, 1}))
+	// Output:
+	// [2 4] true
+	// [] false
+}
+
+func TestWhichNotEqualStringSlice(t *testing.T) {
+	actual, exists := WhichNotEqualStringSlice([]string{"a", "b", "b"})
+	if !AreEqualSlicesInt(actual, []int{0}) || !exists {
+		t.Errorf("WhichNotEqualStringSlice([a b b]) = %v, %v; want [0], true", actual, exists)
+	}
+}
+
+func TestWhichNotEqualBoolSlice(t *testing.T) {
+	actual, exists := WhichNotEqualBoolSlice([]bool{true, false, true, true})
+	if !AreEqualSlicesInt(actual, []int{1}) || !exists {
+		t.Errorf("WhichNotEqualBoolSlice([true false true true]) = %v, %v; want [1], true", actual, exists)
+	}
+}
+
+func TestWhichNotEqualFloat64Slice(t *testing.T) {
+	tests := []struct {
+		input    []float64
+		epsilon  float64
+		expected []int
+	}{
+		{[]float64{}, 0, []int{}},
+		{[]float64{.1, .1}, 0, []int{}},
+		{[]float64{.1, .2, .1}, 0, []int{1}},
+		{[]float64{.1, .2, .11, .3}, .01, []int{1, 3}},
+		{[]float64{.2, .1, .11, .3}, .01, []int{0, 3}},
+		{[]float64{.1, .2}, 0, []int{1}},
+		{[]float64{0, 1, 2}, 1, []int{}},
+	}
+	for _, test := range tests {
+		actual, exists := WhichNotEqualFloat64Slice(test.input, test.epsilon)
+		if !AreEqualSlicesInt(actual, test.expected) || exists != (len(test.expected) > 0) {
+			t.Errorf("WhichNotEqualFloat64Slice(%v, %v) = %v, %v; want %v", test.input, test.epsilon, actual, exists, test.expected)
+		}
+	}
+}
+
+func TestWhichNotEqualMaps(t *testing.T) {
+	keys, exists := WhichNotEqualMapStringInt(map[string]int{"a": 1, "b": 2, "c": 1, "d": 3})
+	if !AreEqualSlicesString(keys, []string{"b", "d"}) || !exists {
+		t.Errorf("WhichNotEqualMapStringInt(map[a:1 b:2 c:1 d:3]) = %v, %v; want [b d], true", keys, exists)
+	}
+	keys, exists = WhichNotEqualMapStringString(map[string]string{"b": "x", "a": "y"})
+	if !AreEqualSlicesString(keys, []string{"b"}) || !exists {
+		t.Errorf("WhichNotEqualMapStringString(map[a:y b:x]) = %v, %v; want [b], true", keys, exists)
+	}
+	keys, exists = WhichNotEqualMapStringFloat64(map[string]float64{"a": 1, "b": 1.05, "c": 2}, .1)
+	if !AreEqualSlicesString(keys, []string{"c"}) || !exists {
+		t.Errorf("WhichNotEqualMapStringFloat64(map[a:1 b:1.05 c:2], .1) = %v, %v; want [c], true", keys, exists)
+	}
+	keys, exists = WhichNotEqualMapStringBool(map[string]bool{"a": true, "b": true})
+	if len(keys) != 0 || exists {
+		t.Errorf("WhichNotEqualMapStringBool(map[a:true b:true]) = %v, %v; want [], false", keys, exists)
+	}
+	intKeys, exists := WhichNotEqualMapIntInt(map[int]int{3: 5, 1: 5, 2: 6})
+	if !AreEqualSlicesInt(intKeys, []int{2}) || !exists {
+		t.Errorf("WhichNotEqualMapIntInt(map[1:5 2:6 3:5]) = %v, %v; want [2], true", intKeys, exists)
+	}
+	intKeys, exists = WhichNotEqualMapIntString(map[int]string{1: "a", 2: "b", 3: "c"})
+	if !AreEqualSlicesInt(intKeys, []int{2, 3}) || !exists {
+		t.Errorf("WhichNotEqualMapIntString(map[1:a 2:b 3:c]) = %v, %v; want [2 3], true", intKeys, exists)
+	}
+	intKeys, exists = WhichNotEqualMapIntFloat64(map[int]float64{1: .5, 2: 1, 3: 1}, 0)
+	if !AreEqualSlicesInt(intKeys, []int{1}) || !exists {
+		t.Errorf("WhichNotEqualMapIntFloat64(map[1:.5 2:1 3:1], 0) = %v, %v; want [1], true", intKeys, exists)
+	}
+	intKeys, exists = WhichNotEqualMapIntBool(map[int]bool{1: false, 2: true, 3: false})
+	if !AreEqualSlicesInt(intKeys, []int{2}) || !exists {
+		t.Errorf("WhichNotEqualMapIntBool(map[1:false 2:true 3:false]) = %v, %v; want [2], true", intKeys, exists)
+	}
+}
+
+func ExampleWhichNotEqualMapStringString() {
+	fmt.Println(WhichNotEqualMapStringString(map[string]string{"a": "x", "b": "y", "c": "x"}))
+	// Output:
+	// [b] true
+}
+
+func TestAllEqualTol(t *testing.T) {
+	if !AllEqualTol([]float64{100, 101, 99}, RelTolerance(.02)) {
+		t.Errorf("AllEqualTol([100 101 99], rtol = 0.02) should be true")
+	}
+	if AllEqualTol([]float64{100, 103}, RelTolerance(.02)) {
+		t.Errorf("AllEqualTol([100 103], rtol = 0.02) should be false")
+	}
+	nans := []float64{math.NaN(), math.NaN()}
+	if !AllEqualTol(nans, AbsTolerance(0).WithPolicy(MissingDataPolicy)) {
+		t.Errorf("AllEqualTol([NaN NaN]) with MissingDataPolicy should be true")
+	}
+	if !AllEqual([]float64{math.NaN()}) || !AllEqualTol([]float64{math.NaN()}, AbsTolerance(0)) {
+		t.Errorf("AllEqual([NaN]) and AllEqualTol([NaN]) should be true")
+	}
+	if !AllEqualFloat([]float32{1, 1.05}, .1) {
+		t.Errorf("AllEqualFloat([1 1.05], .1) should be true")
+	}
+	if !AllEqualMapFloat(map[string]float32{"a": 1, "b": 1.05}, .1) {
+		t.Errorf("AllEqualMapFloat(map[a:1 b:1.05], .1) should be true")
+	}
+}
@@ -0,0 +1,89 @@
+package check
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrNoChecks is returned by AllErr and AnyErr (and their concurrent versions) when they get no checks,
+// in line with All and Any, which return false for no conditions.
+var ErrNoChecks = errors.New("check: no checks to run")
+
+// AllErr runs all checks in order and returns nil if all of them succeed (return nil).
+// Otherwise, it returns the errors of all failing checks, in the order of the checks, joined with errors.Join,
+// so that each of them can be found with errors.Is and errors.As.
+// For no checks, it returns ErrNoChecks.
+func AllErr(Checks ...func() error) error {
+	if len(Checks) == 0 {
+		return ErrNoChecks
+	}
+	errs := make([]error, len(Checks))
+	for i, check := range Checks {
+		errs[i] = check()
+	}
+	return errors.Join(errs...)
+}
+
+// AnyErr runs the checks in order until one of them succeeds (returns nil), in which case it returns nil.
+// When all checks fail, it returns the errors of all of them, in the order of the checks, joined with errors.Join.
+// For no checks, it returns ErrNoChecks.
+func AnyErr(Checks ...func() error) error {
+	if len(Checks) == 0 {
+		return ErrNoChecks
+	}
+	errs := make([]error, len(Checks))
+	for i, check := range Checks {
+		if errs[i] = check(); errs[i] == nil {
+			return nil
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AllErrConcurrent works like AllErr, but runs the checks concurrently, so they must be independent of each other.
+// It waits for all checks to finish. The errors are joined in the order of the checks, not in the order they were returned.
+func AllErrConcurrent(Checks ...func() error) error {
+	if len(Checks) == 0 {
+		return ErrNoChecks
+	}
+	errs := make([]error, len(Checks))
+	var wg sync.WaitGroup
+	wg.Add(len(Checks))
+	for i, check := range Checks {
+		go func(i int, check func() error) {
+			defer wg.Done()
+			errs[i] = check()
+		}(i, check)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// AnyErrConcurrent works like AnyErr, but runs the checks concurrently, so they must be independent of each other.
+// It returns nil as soon as any check succeeds, without waiting for the other checks to finish.
+// When all checks fail, the errors are joined in the order of the checks, not in the order they were returned.
+func AnyErrConcurrent(Checks ...func() error) error {
+	if len(Checks) == 0 {
+		return ErrNoChecks
+	}
+	type result struct {
+		index int
+		err   error
+	}
+	// The channel is buffered, so that the checks still running after a success do not block forever.
+	results := make(chan result, len(Checks))
+	for i, check := range Checks {
+		go func(i int, check func() error) {
+			results <- result{i, check()}
+		}(i, check)
+	}
+	errs := make([]error, len(Checks))
+	for range Checks {
+		r := <-results
+		if r.err == nil {
+			return nil
+		}
+		errs[r.index] = r.err
+	}
+	return errors.Join(errs...)
+}
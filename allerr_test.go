@@ -0,0 +1,120 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var (
+	errQuota   = errors.New("quota exceeded")
+	errTimeout = errors.New("timeout")
+)
+
+type fieldError struct {
+	Field string
+}
+
+func (e *fieldError) Error() string {
+	return "invalid " + e.Field
+}
+
+func succeed() error { return nil }
+
+func TestAllErr(t *testing.T) {
+	for name, allErr := range map[string]func(...func() error) error{"AllErr": AllErr, "AllErrConcurrent": AllErrConcurrent} {
+		if err := allErr(succeed, succeed); err != nil {
+			t.Errorf("%v(succeed, succeed) = %v; want nil", name, err)
+		}
+		if err := allErr(); err != ErrNoChecks {
+			t.Errorf("%v() = %v; want ErrNoChecks", name, err)
+		}
+		err := allErr(
+			func() error { return errQuota },
+			succeed,
+			func() error { return fmt.Errorf("user: %w", &fieldError{"email"}) },
+		)
+		if !errors.Is(err, errQuota) || errors.Is(err, errTimeout) {
+			t.Errorf("%v: errors.Is should find exactly the errors of the failing checks in %v", name, err)
+		}
+		var fe *fieldError
+		if !errors.As(err, &fe) || fe.Field != "email" {
+			t.Errorf("%v: errors.As should find the wrapped *fieldError in %v", name, err)
+		}
+		if err.Error() != "quota exceeded\nuser: invalid email" {
+			t.Errorf("%v: error message = %q; want the failing checks' messages in order", name, err.Error())
+		}
+	}
+}
+
+func TestAnyErr(t *testing.T) {
+	var calls int
+	counted := func(err error) func() error {
+		return func() error {
+			calls++
+			return err
+		}
+	}
+	if err := AnyErr(counted(errQuota), counted(nil), counted(errTimeout)); err != nil || calls != 2 {
+		t.Errorf("AnyErr should return nil after 2 calls, got %v after %v calls", err, calls)
+	}
+	err := AnyErr(counted(errQuota), counted(errTimeout))
+	if !errors.Is(err, errQuota) || !errors.Is(err, errTimeout) || err.Error() != "quota exceeded\ntimeout" {
+		t.Errorf("AnyErr should join all attempts, got %v", err)
+	}
+	if err := AnyErr(); err != ErrNoChecks {
+		t.Errorf("AnyErr() = %v; want ErrNoChecks", err)
+	}
+}
+
+func TestAnyErrConcurrent(t *testing.T) {
+	// The blocked check finishes only after AnyErrConcurrent returns, so the call returns at the first success.
+	release := make(chan struct{})
+	blocked := func() error {
+		<-release
+		return errTimeout
+	}
+	err := AnyErrConcurrent(blocked, succeed)
+	close(release)
+	if err != nil {
+		t.Errorf("AnyErrConcurrent(blocked, succeed) = %v; want nil", err)
+	}
+	err = AnyErrConcurrent(
+		func() error { time.Sleep(20 * time.Millisecond); return errQuota },
+		func() error { return errTimeout },
+	)
+	if err == nil || err.Error() != "quota exceeded\ntimeout" {
+		t.Errorf("AnyErrConcurrent should join the errors in the order of the checks, got %v", err)
+	}
+	if err := AnyErrConcurrent(); err != ErrNoChecks {
+		t.Errorf("AnyErrConcurrent() = %v; want ErrNoChecks", err)
+	}
+}
+
+func TestAllErrConcurrentRunsAllChecks(t *testing.T) {
+	var calls int32
+	checks := make([]func() error, 100)
+	for i := range checks {
+		checks[i] = func() error {
+			atomic.AddInt32(&calls, 1)
+			return errQuota
+		}
+	}
+	if err := AllErrConcurrent(checks...); !errors.Is(err, errQuota) || calls != 100 {
+		t.Errorf("AllErrConcurrent should run all 100 checks, ran %v and returned %v", calls, err)
+	}
+}
+
+func ExampleAllErr() {
+	err := AllErr(
+		func() error { return nil },
+		func() error { return errors.New("name is empty") },
+		func() error { return errors.New("age is negative") },
+	)
+	fmt.Println(err)
+	// Output:
+	// name is empty
+	// age is negative
+}
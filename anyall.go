@@ -13,8 +13,9 @@ func Any(Conditions []bool) bool {
 	return false
 }
 
-// AnyInMapInt checks if any of the map's values is true. For no conditions, it returns false.
-func AnyInMapInt(Conditions map[int]bool) bool {
+// AnyInMap checks if any of the map's values is true. It works with maps of any comparable key type.
+// For no conditions, it returns false.
+func AnyInMap[K comparable](Conditions map[K]bool) bool {
 	if len(Conditions) == 0 {
 		return false
 	}
@@ -26,14 +27,20 @@ func AnyInMapInt(Conditions map[int]bool) bool {
 	return false
 }
 
-// WhichInMapInt checks which of the map's values is true.
+// AnyInMapInt checks if any of the map's values is true. For no conditions, it returns false.
+func AnyInMapInt(Conditions map[int]bool) bool {
+	return AnyInMap(Conditions)
+}
+
+// WhichInMap checks which of the map's values is true. It works with maps of any comparable key type.
 // Returns a tuple of true/false, keys, where keys are the keys with true.
-func WhichInMapInt(Conditions map[int]bool) ([]int, bool) {
+// The keys are in no particular order; use WhichInMapSorted to get them sorted.
+func WhichInMap[K comparable](Conditions map[K]bool) ([]K, bool) {
 	var exists bool
 	if len(Conditions) == 0 {
-		return []int{}, false
+		return []K{}, false
 	}
-	keys := make([]int, 0)
+	keys := make([]K, 0)
 	for key, condition := range Conditions {
 		if condition {
 			exists = true
@@ -43,35 +50,31 @@ func WhichInMapInt(Conditions map[int]bool) ([]int, bool) {
 	return keys, exists
 }
 
+// WhichInMapSorted checks which of the map's values is true. It works with maps of any ordered key type.
+// Returns a tuple of true/false, keys, where keys are the keys with true, sorted in increasing order.
+func WhichInMapSorted[K Ordered](Conditions map[K]bool) ([]K, bool) {
+	keys, exists := WhichInMap(Conditions)
+	return sortKeys(keys), exists
+}
+
+// WhichInMapInt checks which of the map's values is true.
+// Returns a tuple of true/false, keys, where keys are the keys with true.
+// The keys are sorted in increasing order.
+func WhichInMapInt(Conditions map[int]bool) ([]int, bool) {
+	return WhichInMapSorted(Conditions)
+}
+
 // WhichInMapString checks which of the map's values is true.
 // Returns a tuple of true/false, keys, where keys are the keys with true.
+// The keys are sorted in increasing order.
 func WhichInMapString(Conditions map[string]bool) ([]string, bool) {
-	var exists bool
-	if len(Conditions) == 0 {
-		return []string{}, false
-	}
-	keys := make([]string, 0)
-	for key, condition := range Conditions {
-		if condition {
-			exists = true
-			keys = append(keys, key)
-		}
-	}
-	return keys, exists
+	return WhichInMapSorted(Conditions)
 }
 
 // AnyInMapString checks if any of the map's values is true.
 // Returns a tuple of true/false, keys, where keys are the keys with true.
 func AnyInMapString(Conditions map[string]bool) bool {
-	if len(Conditions) == 0 {
-		return false
-	}
-	for _, condition := range Conditions {
-		if condition {
-			return true
-		}
-	}
-	return false
+	return AnyInMap(Conditions)
 }
 
 // All checks if all conditions are met. For no conditions, it returns false.
@@ -87,8 +90,9 @@ func All(Conditions []bool) bool {
 	return true
 }
 
-// AllMapInt checks if all values in the map are true. For no conditions, it returns false.
-func AllInMapInt(Conditions map[int]bool) bool {
+// AllInMap checks if all values in the map are true. It works with maps of any comparable key type.
+// For no conditions, it returns false.
+func AllInMap[K comparable](Conditions map[K]bool) bool {
 	if len(Conditions) == 0 {
 		return false
 	}
@@ -100,15 +104,12 @@ func AllInMapInt(Conditions map[int]bool) bool {
 	return true
 }
 
+// AllMapInt checks if all values in the map are true. For no conditions, it returns false.
+func AllInMapInt(Conditions map[int]bool) bool {
+	return AllInMap(Conditions)
+}
+
 // AllInMapString checks if all values in the map are true. For no conditions, it returns false.
 func AllInMapString(Conditions map[string]bool) bool {
-	if len(Conditions) == 0 {
-		return false
-	}
-	for _, condition := range Conditions {
-		if !condition {
-			return false
-		}
-	}
-	return true
+	return AllInMap(Conditions)
 }
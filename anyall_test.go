@@ -261,3 +261,62 @@ func ExampleAllInMapString() {
 	// false
 	// false
 }
+
+type flag string
+
+func TestAnyInMap(t *testing.T) {
+	tests := []struct {
+		conditions map[flag]bool
+		expected   bool
+	}{
+		{map[flag]bool{}, false},
+		{map[flag]bool{"verbose": true, "quiet": false}, true},
+		{map[flag]bool{"verbose": false, "quiet": false}, false},
+	}
+	for _, test := range tests {
+		if AnyInMap(test.conditions) != test.expected {
+			t.Errorf("AnyInMap(%v) should be %v", test.conditions, test.expected)
+		}
+	}
+}
+
+func TestAllInMap(t *testing.T) {
+	tests := []struct {
+		conditions map[int64]bool
+		expected   bool
+	}{
+		{map[int64]bool{}, false},
+		{map[int64]bool{1: true, 2: true}, true},
+		{map[int64]bool{1: true, 2: false}, false},
+	}
+	for _, test := range tests {
+		if AllInMap(test.conditions) != test.expected {
+			t.Errorf("AllInMap(%v) should be %v", test.conditions, test.expected)
+		}
+	}
+}
+
+func TestWhichInMap(t *testing.T) {
+	tests := []struct {
+		conditions   map[uint8]bool
+		expectedBool bool
+		expectedKeys []uint8
+	}{
+		{map[uint8]bool{}, false, []uint8{}},
+		{map[uint8]bool{1: true, 2: false, 3: true}, true, []uint8{1, 3}},
+		{map[uint8]bool{1: false, 2: false}, false, []uint8{}},
+	}
+	for _, test := range tests {
+		keys, ok := WhichInMap(test.conditions)
+		if ok != test.expectedBool || !AreEqualSortedSlices(keys, test.expectedKeys) {
+			t.Errorf("WhichInMap(%v) should be %v with keys %v", test.conditions, test.expectedBool, test.expectedKeys)
+		}
+	}
+}
+
+func ExampleWhichInMap() {
+	keys, ok := WhichInMap(map[flag]bool{"verbose": true, "quiet": false})
+	fmt.Println(ok, keys)
+	// Output:
+	// true [verbose]
+}
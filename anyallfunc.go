@@ -0,0 +1,349 @@
+package check
+
+// AnyFunc checks if any element of a slice meets a condition, given as a predicate. It works with slices of any type.
+// It stops at the first element meeting the condition. For an empty slice, it returns false.
+func AnyFunc[T any](Slice []T, Predicate func(T) bool) bool {
+	for _, value := range Slice {
+		if Predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllFunc checks if all elements of a slice meet a condition, given as a predicate. It works with slices of any type.
+// It stops at the first element not meeting the condition. For an empty slice, it returns false, like All.
+func AllFunc[T any](Slice []T, Predicate func(T) bool) bool {
+	if len(Slice) == 0 {
+		return false
+	}
+	for _, value := range Slice {
+		if !Predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneFunc checks if no element of a slice meets a condition, given as a predicate. It works with slices of any type.
+// It stops at the first element meeting the condition. For an empty slice, it returns true.
+func NoneFunc[T any](Slice []T, Predicate func(T) bool) bool {
+	return !AnyFunc(Slice, Predicate)
+}
+
+// WhichFunc checks which elements of a slice meet a condition, given as a predicate. It works with slices of any type.
+// Returns a tuple of the indices of the elements meeting the condition, in increasing order,
+// and true if any element meets it.
+func WhichFunc[T any](Slice []T, Predicate func(T) bool) ([]int, bool) {
+	indices := make([]int, 0)
+	for index, value := range Slice {
+		if Predicate(value) {
+			indices = append(indices, index)
+		}
+	}
+	return indices, len(indices) > 0
+}
+
+// AnyInMapFunc checks if any key-value pair of a map meets a condition, given as a predicate.
+// It works with maps of any comparable key type and any value type.
+// It stops at the first pair meeting the condition. For an empty map, it returns false.
+func AnyInMapFunc[K comparable, V any](Map map[K]V, Predicate func(K, V) bool) bool {
+	for key, value := range Map {
+		if Predicate(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllInMapFunc checks if all key-value pairs of a map meet a condition, given as a predicate.
+// It works with maps of any comparable key type and any value type.
+// It stops at the first pair not meeting the condition. For an empty map, it returns false, like AllInMap.
+func AllInMapFunc[K comparable, V any](Map map[K]V, Predicate func(K, V) bool) bool {
+	if len(Map) == 0 {
+		return false
+	}
+	for key, value := range Map {
+		if !Predicate(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneInMapFunc checks if no key-value pair of a map meets a condition, given as a predicate.
+// It works with maps of any comparable key type and any value type.
+// It stops at the first pair meeting the condition. For an empty map, it returns true.
+func NoneInMapFunc[K comparable, V any](Map map[K]V, Predicate func(K, V) bool) bool {
+	return !AnyInMapFunc(Map, Predicate)
+}
+
+// WhichInMapFunc checks which key-value pairs of a map meet a condition, given as a predicate.
+// It works with maps of any comparable key type and any value type.
+// Returns a tuple of the keys of the pairs meeting the condition, and true if any pair meets it.
+// The keys are in no particular order; use WhichInMapSortedFunc to get them sorted.
+func WhichInMapFunc[K comparable, V any](Map map[K]V, Predicate func(K, V) bool) ([]K, bool) {
+	keys := make([]K, 0)
+	for key, value := range Map {
+		if Predicate(key, value) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, len(keys) > 0
+}
+
+// WhichInMapSortedFunc checks which key-value pairs of a map meet a condition, given as a predicate.
+// It works with maps of any ordered key type and any value type.
+// Returns a tuple of the keys of the pairs meeting the condition, sorted in increasing order, and true if any pair meets it.
+func WhichInMapSortedFunc[K Ordered, V any](Map map[K]V, Predicate func(K, V) bool) ([]K, bool) {
+	keys, exists := WhichInMapFunc(Map, Predicate)
+	return sortKeys(keys), exists
+}
+
+// AnyIntSliceFunc checks if any element of an int slice meets a condition; see AnyFunc.
+func AnyIntSliceFunc(Slice []int, Predicate func(int) bool) bool {
+	return AnyFunc(Slice, Predicate)
+}
+
+// AllIntSliceFunc checks if all elements of an int slice meet a condition; see AllFunc.
+func AllIntSliceFunc(Slice []int, Predicate func(int) bool) bool {
+	return AllFunc(Slice, Predicate)
+}
+
+// NoneIntSliceFunc checks if no element of an int slice meets a condition; see NoneFunc.
+func NoneIntSliceFunc(Slice []int, Predicate func(int) bool) bool {
+	return NoneFunc(Slice, Predicate)
+}
+
+// WhichIntSliceFunc checks which elements of an int slice meet a condition; see WhichFunc.
+func WhichIntSliceFunc(Slice []int, Predicate func(int) bool) ([]int, bool) {
+	return WhichFunc(Slice, Predicate)
+}
+
+// AnyStringSliceFunc checks if any element of a string slice meets a condition; see AnyFunc.
+func AnyStringSliceFunc(Slice []string, Predicate func(string) bool) bool {
+	return AnyFunc(Slice, Predicate)
+}
+
+// AllStringSliceFunc checks if all elements of a string slice meet a condition; see AllFunc.
+func AllStringSliceFunc(Slice []string, Predicate func(string) bool) bool {
+	return AllFunc(Slice, Predicate)
+}
+
+// NoneStringSliceFunc checks if no element of a string slice meets a condition; see NoneFunc.
+func NoneStringSliceFunc(Slice []string, Predicate func(string) bool) bool {
+	return NoneFunc(Slice, Predicate)
+}
+
+// WhichStringSliceFunc checks which elements of a string slice meet a condition; see WhichFunc.
+func WhichStringSliceFunc(Slice []string, Predicate func(string) bool) ([]int, bool) {
+	return WhichFunc(Slice, Predicate)
+}
+
+// AnyFloat64SliceFunc checks if any element of a float64 slice meets a condition; see AnyFunc.
+func AnyFloat64SliceFunc(Slice []float64, Predicate func(float64) bool) bool {
+	return AnyFunc(Slice, Predicate)
+}
+
+// AllFloat64SliceFunc checks if all elements of a float64 slice meet a condition; see AllFunc.
+func AllFloat64SliceFunc(Slice []float64, Predicate func(float64) bool) bool {
+	return AllFunc(Slice, Predicate)
+}
+
+// NoneFloat64SliceFunc checks if no element of a float64 slice meets a condition; see NoneFunc.
+func NoneFloat64SliceFunc(Slice []float64, Predicate func(float64) bool) bool {
+	return NoneFunc(Slice, Predicate)
+}
+
+// WhichFloat64SliceFunc checks which elements of a float64 slice meet a condition; see WhichFunc.
+func WhichFloat64SliceFunc(Slice []float64, Predicate func(float64) bool) ([]int, bool) {
+	return WhichFunc(Slice, Predicate)
+}
+
+// AnyBoolSliceFunc checks if any element of a bool slice meets a condition; see AnyFunc.
+func AnyBoolSliceFunc(Slice []bool, Predicate func(bool) bool) bool {
+	return AnyFunc(Slice, Predicate)
+}
+
+// AllBoolSliceFunc checks if all elements of a bool slice meet a condition; see AllFunc.
+func AllBoolSliceFunc(Slice []bool, Predicate func(bool) bool) bool {
+	return AllFunc(Slice, Predicate)
+}
+
+// NoneBoolSliceFunc checks if no element of a bool slice meets a condition; see NoneFunc.
+func NoneBoolSliceFunc(Slice []bool, Predicate func(bool) bool) bool {
+	return NoneFunc(Slice, Predicate)
+}
+
+// WhichBoolSliceFunc checks which elements of a bool slice meet a condition; see WhichFunc.
+func WhichBoolSliceFunc(Slice []bool, Predicate func(bool) bool) ([]int, bool) {
+	return WhichFunc(Slice, Predicate)
+}
+
+// AnyInMapStringStringFunc checks if any key-value pair of map[string]string meets a condition; see AnyInMapFunc.
+func AnyInMapStringStringFunc(Map map[string]string, Predicate func(string, string) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapStringStringFunc checks if all key-value pairs of map[string]string meet a condition; see AllInMapFunc.
+func AllInMapStringStringFunc(Map map[string]string, Predicate func(string, string) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapStringStringFunc checks if no key-value pair of map[string]string meets a condition; see NoneInMapFunc.
+func NoneInMapStringStringFunc(Map map[string]string, Predicate func(string, string) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapStringStringFunc checks which key-value pairs of map[string]string meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapStringStringFunc(Map map[string]string, Predicate func(string, string) bool) ([]string, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapStringIntFunc checks if any key-value pair of map[string]int meets a condition; see AnyInMapFunc.
+func AnyInMapStringIntFunc(Map map[string]int, Predicate func(string, int) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapStringIntFunc checks if all key-value pairs of map[string]int meet a condition; see AllInMapFunc.
+func AllInMapStringIntFunc(Map map[string]int, Predicate func(string, int) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapStringIntFunc checks if no key-value pair of map[string]int meets a condition; see NoneInMapFunc.
+func NoneInMapStringIntFunc(Map map[string]int, Predicate func(string, int) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapStringIntFunc checks which key-value pairs of map[string]int meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapStringIntFunc(Map map[string]int, Predicate func(string, int) bool) ([]string, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapStringFloat64Func checks if any key-value pair of map[string]float64 meets a condition; see AnyInMapFunc.
+func AnyInMapStringFloat64Func(Map map[string]float64, Predicate func(string, float64) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapStringFloat64Func checks if all key-value pairs of map[string]float64 meet a condition; see AllInMapFunc.
+func AllInMapStringFloat64Func(Map map[string]float64, Predicate func(string, float64) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapStringFloat64Func checks if no key-value pair of map[string]float64 meets a condition; see NoneInMapFunc.
+func NoneInMapStringFloat64Func(Map map[string]float64, Predicate func(string, float64) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapStringFloat64Func checks which key-value pairs of map[string]float64 meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapStringFloat64Func(Map map[string]float64, Predicate func(string, float64) bool) ([]string, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapStringBoolFunc checks if any key-value pair of map[string]bool meets a condition; see AnyInMapFunc.
+func AnyInMapStringBoolFunc(Map map[string]bool, Predicate func(string, bool) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapStringBoolFunc checks if all key-value pairs of map[string]bool meet a condition; see AllInMapFunc.
+func AllInMapStringBoolFunc(Map map[string]bool, Predicate func(string, bool) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapStringBoolFunc checks if no key-value pair of map[string]bool meets a condition; see NoneInMapFunc.
+func NoneInMapStringBoolFunc(Map map[string]bool, Predicate func(string, bool) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapStringBoolFunc checks which key-value pairs of map[string]bool meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapStringBoolFunc(Map map[string]bool, Predicate func(string, bool) bool) ([]string, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapIntStringFunc checks if any key-value pair of map[int]string meets a condition; see AnyInMapFunc.
+func AnyInMapIntStringFunc(Map map[int]string, Predicate func(int, string) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapIntStringFunc checks if all key-value pairs of map[int]string meet a condition; see AllInMapFunc.
+func AllInMapIntStringFunc(Map map[int]string, Predicate func(int, string) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapIntStringFunc checks if no key-value pair of map[int]string meets a condition; see NoneInMapFunc.
+func NoneInMapIntStringFunc(Map map[int]string, Predicate func(int, string) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapIntStringFunc checks which key-value pairs of map[int]string meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapIntStringFunc(Map map[int]string, Predicate func(int, string) bool) ([]int, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapIntIntFunc checks if any key-value pair of map[int]int meets a condition; see AnyInMapFunc.
+func AnyInMapIntIntFunc(Map map[int]int, Predicate func(int, int) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapIntIntFunc checks if all key-value pairs of map[int]int meet a condition; see AllInMapFunc.
+func AllInMapIntIntFunc(Map map[int]int, Predicate func(int, int) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapIntIntFunc checks if no key-value pair of map[int]int meets a condition; see NoneInMapFunc.
+func NoneInMapIntIntFunc(Map map[int]int, Predicate func(int, int) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapIntIntFunc checks which key-value pairs of map[int]int meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapIntIntFunc(Map map[int]int, Predicate func(int, int) bool) ([]int, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapIntFloat64Func checks if any key-value pair of map[int]float64 meets a condition; see AnyInMapFunc.
+func AnyInMapIntFloat64Func(Map map[int]float64, Predicate func(int, float64) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapIntFloat64Func checks if all key-value pairs of map[int]float64 meet a condition; see AllInMapFunc.
+func AllInMapIntFloat64Func(Map map[int]float64, Predicate func(int, float64) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapIntFloat64Func checks if no key-value pair of map[int]float64 meets a condition; see NoneInMapFunc.
+func NoneInMapIntFloat64Func(Map map[int]float64, Predicate func(int, float64) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapIntFloat64Func checks which key-value pairs of map[int]float64 meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapIntFloat64Func(Map map[int]float64, Predicate func(int, float64) bool) ([]int, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
+
+// AnyInMapIntBoolFunc checks if any key-value pair of map[int]bool meets a condition; see AnyInMapFunc.
+func AnyInMapIntBoolFunc(Map map[int]bool, Predicate func(int, bool) bool) bool {
+	return AnyInMapFunc(Map, Predicate)
+}
+
+// AllInMapIntBoolFunc checks if all key-value pairs of map[int]bool meet a condition; see AllInMapFunc.
+func AllInMapIntBoolFunc(Map map[int]bool, Predicate func(int, bool) bool) bool {
+	return AllInMapFunc(Map, Predicate)
+}
+
+// NoneInMapIntBoolFunc checks if no key-value pair of map[int]bool meets a condition; see NoneInMapFunc.
+func NoneInMapIntBoolFunc(Map map[int]bool, Predicate func(int, bool) bool) bool {
+	return NoneInMapFunc(Map, Predicate)
+}
+
+// WhichInMapIntBoolFunc checks which key-value pairs of map[int]bool meet a condition; see WhichInMapFunc.
+// The keys are sorted in increasing order.
+func WhichInMapIntBoolFunc(Map map[int]bool, Predicate func(int, bool) bool) ([]int, bool) {
+	return WhichInMapSortedFunc(Map, Predicate)
+}
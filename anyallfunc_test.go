@@ -0,0 +1,103 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSliceFunc(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	tests := []struct {
+		Slice []int
+		any   bool
+		all   bool
+		none  bool
+		which []int
+	}{
+		{[]int{}, false, false, true, []int{}},
+		{[]int{1, 3}, false, false, true, []int{}},
+		{[]int{1, 2, 3, 4}, true, false, false, []int{1, 3}},
+		{[]int{2, 4}, true, true, false, []int{0, 1}},
+	}
+	for _, test := range tests {
+		if got := AnyIntSliceFunc(test.Slice, isEven); got != test.any {
+			t.Errorf("AnyIntSliceFunc(%v, isEven) = %v; want %v", test.Slice, got, test.any)
+		}
+		if got := AllIntSliceFunc(test.Slice, isEven); got != test.all {
+			t.Errorf("AllIntSliceFunc(%v, isEven) = %v; want %v", test.Slice, got, test.all)
+		}
+		if got := NoneIntSliceFunc(test.Slice, isEven); got != test.none {
+			t.Errorf("NoneIntSliceFunc(%v, isEven) = %v; want %v", test.Slice, got, test.none)
+		}
+		got, ok := WhichIntSliceFunc(test.Slice, isEven)
+		if !AreEqualSlicesInt(got, test.which) || ok != (len(test.which) > 0) {
+			t.Errorf("WhichIntSliceFunc(%v, isEven) = %v, %v; want %v", test.Slice, got, ok, test.which)
+		}
+	}
+}
+
+func TestSliceFuncShortCircuits(t *testing.T) {
+	var calls int
+	isPositive := func(x float64) bool {
+		calls++
+		return x > 0
+	}
+	tests := []struct {
+		name  string
+		check func() bool
+		calls int
+	}{
+		{"AnyFunc", func() bool { return AnyFunc([]float64{-1, 2, 3, 4}, isPositive) }, 2},
+		{"AllFunc", func() bool { return AllFunc([]float64{1, -2, 3, 4}, isPositive) }, 2},
+		{"NoneFunc", func() bool { return NoneFunc([]float64{-1, -2, 3, 4}, isPositive) }, 3},
+	}
+	for _, test := range tests {
+		calls = 0
+		test.check()
+		if calls != test.calls {
+			t.Errorf("%v called the predicate %v times; want %v", test.name, calls, test.calls)
+		}
+	}
+	calls = 0
+	AnyInMapFunc(map[int]float64{1: 1, 2: 2, 3: 3}, func(_ int, x float64) bool { return isPositive(x) })
+	if calls != 1 {
+		t.Errorf("AnyInMapFunc called the predicate %v times; want 1", calls)
+	}
+}
+
+func TestMapFunc(t *testing.T) {
+	stock := map[string]int{"apples": 0, "pears": 5, "plums": 12}
+	inStock := func(_ string, count int) bool { return count > 0 }
+	if !AnyInMapStringIntFunc(stock, inStock) || AllInMapStringIntFunc(stock, inStock) || NoneInMapStringIntFunc(stock, inStock) {
+		t.Errorf("AnyInMapStringIntFunc, AllInMapStringIntFunc and NoneInMapStringIntFunc should check the predicate for %v", stock)
+	}
+	keys, ok := WhichInMapStringIntFunc(stock, inStock)
+	if !ok || !AreEqualSlicesString(keys, []string{"pears", "plums"}) {
+		t.Errorf("WhichInMapStringIntFunc(%v, inStock) = %v, %v; want [pears plums] true", stock, keys, ok)
+	}
+	if AllInMapFunc(map[int]bool{}, func(int, bool) bool { return true }) || !NoneInMapFunc(map[int]bool{}, func(int, bool) bool { return true }) {
+		t.Errorf("AllInMapFunc of an empty map should be false and NoneInMapFunc true")
+	}
+	byKey := func(key int, _ string) bool { return key > 1 }
+	if keys, ok := WhichInMapIntStringFunc(map[int]string{3: "c", 1: "a", 2: "b"}, byKey); !ok || !AreEqualSlicesInt(keys, []int{2, 3}) {
+		t.Errorf("WhichInMapIntStringFunc = %v, %v; want [2 3] true", keys, ok)
+	}
+}
+
+func ExampleWhichFunc() {
+	words := []string{"go", "check", "map", "slice"}
+	long := func(s string) bool { return len(s) > 3 }
+	fmt.Println(AnyFunc(words, long), AllFunc(words, long), NoneFunc(words, long))
+	fmt.Println(WhichFunc(words, long))
+	// Output:
+	// true false false
+	// [1 3] true
+}
+
+func ExampleWhichInMapSortedFunc() {
+	readings := map[string]float64{"b": 21.5, "a": 35.2, "c": 40.1}
+	tooHot := func(_ string, t float64) bool { return t > 30 }
+	fmt.Println(WhichInMapSortedFunc(readings, tooHot))
+	// Output:
+	// [a c] true
+}
@@ -1,10 +1,9 @@
 package check
 
-import "math"
-
-// AllKeyValuePairsInMapStringString checks if all key-value pairs from one map[string]string are in another map[string]string.
+// AllKeyValuePairsInMap checks if all key-value pairs from one map are in another map.
+// It works with maps of any comparable key and value types.
 // When any of the maps is empty, the function returns false.
-func AllKeyValuePairsInMapStringString(Map1, Map2 map[string]string) bool {
+func AllKeyValuePairsInMap[K comparable, V comparable](Map1, Map2 map[K]V) bool {
 	if len(Map1) == 0 || len(Map2) == 0 {
 		return false
 	}
@@ -20,85 +19,109 @@ func AllKeyValuePairsInMapStringString(Map1, Map2 map[string]string) bool {
 	return true
 }
 
-// AnyKeyValuePairInMapStringString checks if any key-value pair from one map[string]string is in another map[string]string.
+// AllKeyValuePairsInMapTol checks if all key-value pairs from one map are in another map.
+// It works with maps of any comparable key type and any float value type.
 // When any of the maps is empty, the function returns false.
-func AnyKeyValuePairInMapStringString(Map1, Map2 map[string]string) bool {
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func AllKeyValuePairsInMapTol[K comparable, V Float](Map1, Map2 map[K]V, Tol Tolerance) bool {
 	if len(Map1) == 0 || len(Map2) == 0 {
 		return false
 	}
 	for key, valueMap1 := range Map1 {
 		valueMap2, ok := Map2[key]
-		if ok {
-			if valueMap1 == valueMap2 {
-				return true
-			}
+		if !ok {
+			return false
+		}
+		if !isWithinTolerance(valueMap1, valueMap2, Tol) {
+			return false
 		}
 	}
-	return false
+	return true
 }
 
-// WhichKeyValuePairsInMapStringString checks which key-value pairs from one map[string]string is in another map[string]string.
-// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
-// When any of the maps is empty, the function returns empty slice and false.
-func WhichKeyValuePairsInMapStringString(Map1, Map2 map[string]string) (map[string]string, bool) {
-	keys := make(map[string]string)
-	var exists bool
+// AllKeyValuePairsInMapFloat checks if all key-value pairs from one map are in another map.
+// It works with maps of any comparable key type and any float value type.
+// When any of the maps is empty, the function returns false.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AllKeyValuePairsInMapFloat[K comparable, V Float](Map1, Map2 map[K]V, Epsilon float64) bool {
+	return AllKeyValuePairsInMapTol(Map1, Map2, AbsTolerance(Epsilon))
+}
 
+// AnyKeyValuePairInMap checks if any key-value pair from one map is in another map.
+// It works with maps of any comparable key and value types.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMap[K comparable, V comparable](Map1, Map2 map[K]V) bool {
 	if len(Map1) == 0 || len(Map2) == 0 {
-		return keys, false
+		return false
 	}
 	for key, valueMap1 := range Map1 {
 		valueMap2, ok := Map2[key]
 		if ok {
 			if valueMap1 == valueMap2 {
-				exists = true
-				keys[key] = valueMap1
+				return true
 			}
 		}
 	}
-	return keys, exists
+	return false
 }
 
-// AllKeyValuePairsInMapStringInt checks if all key-value pairs from one map[string]int are in another map[string]int.
+// AnyKeyValuePairInMapTol checks if any key-value pair from one map is in another map.
+// It works with maps of any comparable key type and any float value type.
 // When any of the maps is empty, the function returns false.
-func AllKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) bool {
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func AnyKeyValuePairInMapTol[K comparable, V Float](Map1, Map2 map[K]V, Tol Tolerance) bool {
 	if len(Map1) == 0 || len(Map2) == 0 {
 		return false
 	}
 	for key, valueMap1 := range Map1 {
 		valueMap2, ok := Map2[key]
-		if !ok {
-			return false
-		}
-		if valueMap1 != valueMap2 {
-			return false
+		if ok {
+			if isWithinTolerance(valueMap1, valueMap2, Tol) {
+				return true
+			}
 		}
 	}
-	return true
+	return false
 }
 
-// AnyKeyValuePairInMapStringInt checks if any key-value pair from one map[string]int is in another map[string]int.
+// AnyKeyValuePairInMapFloat checks if any key-value pair from one map is in another map.
+// It works with maps of any comparable key type and any float value type.
 // When any of the maps is empty, the function returns false.
-func AnyKeyValuePairInMapStringInt(Map1, Map2 map[string]int) bool {
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AnyKeyValuePairInMapFloat[K comparable, V Float](Map1, Map2 map[K]V, Epsilon float64) bool {
+	return AnyKeyValuePairInMapTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// WhichKeyValuePairsInMap checks which key-value pairs from one map are in another map.
+// It works with maps of any comparable key and value types.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+// When any of the maps is empty, the function returns an empty map and false.
+func WhichKeyValuePairsInMap[K comparable, V comparable](Map1, Map2 map[K]V) (map[K]V, bool) {
+	keys := make(map[K]V)
+	var exists bool
+
 	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
+		return keys, false
 	}
 	for key, valueMap1 := range Map1 {
 		valueMap2, ok := Map2[key]
 		if ok {
 			if valueMap1 == valueMap2 {
-				return true
+				exists = true
+				keys[key] = valueMap1
 			}
 		}
 	}
-	return false
+	return keys, exists
 }
 
-// WhichKeyValuePairsInMapStringInt checks which key-value pair from one map[string]int is in another map[string]int.
+// WhichKeyValuePairsInMapTol checks which key-value pairs from one map are in another map.
+// It works with maps of any comparable key type and any float value type.
 // Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
-// When any of the maps is empty, the function returns empty slice and false.
-func WhichKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) (map[string]int, bool) {
-	keys := make(map[string]int)
+// When any of the maps is empty, the function returns an empty map and false.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func WhichKeyValuePairsInMapTol[K comparable, V Float](Map1, Map2 map[K]V, Tol Tolerance) (map[K]V, bool) {
+	keys := make(map[K]V)
 	var exists bool
 
 	if len(Map1) == 0 || len(Map2) == 0 {
@@ -107,7 +130,7 @@ func WhichKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) (map[string]int
 	for key, valueMap1 := range Map1 {
 		valueMap2, ok := Map2[key]
 		if ok {
-			if valueMap1 == valueMap2 {
+			if isWithinTolerance(valueMap1, valueMap2, Tol) {
 				exists = true
 				keys[key] = valueMap1
 			}
@@ -116,41 +139,129 @@ func WhichKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) (map[string]int
 	return keys, exists
 }
 
-// AllKeyValuePairsInMapStringFloat64 checks if all key-value pairs from one map[string]float64 are in another map[string]float64.
-// When any of the maps is empty, the function returns false.
+// WhichKeyValuePairsInMapFloat checks which key-value pairs from one map are in another map.
+// It works with maps of any comparable key type and any float value type.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+// When any of the maps is empty, the function returns an empty map and false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
-func AllKeyValuePairsInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
+func WhichKeyValuePairsInMapFloat[K comparable, V Float](Map1, Map2 map[K]V, Epsilon float64) (map[K]V, bool) {
+	return WhichKeyValuePairsInMapTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// PairMismatch describes a key-value pair of one map that is not in another map.
+// Value is the pair's value, OtherValue is the value of the same key in the other map,
+// and KeyAbsent is true when the other map does not have the key at all (then OtherValue is the zero value).
+type PairMismatch[V any] struct {
+	Value      V
+	OtherValue V
+	KeyAbsent  bool
+}
+
+// WhichKeyValuePairsNotInMap checks which key-value pairs from one map are not in another map.
+// It works with maps of any comparable key and value types.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values
+// (so that a key absent from Map2 can be told apart from a key with a different value), and true if the map is not empty.
+// When Map1 is empty, the function returns an empty map and false. When Map2 is empty, all key-value pairs of Map1 are missing.
+func WhichKeyValuePairsNotInMap[K comparable, V comparable](Map1, Map2 map[K]V) (map[K]PairMismatch[V], bool) {
+	return whichKeyValuePairsNotInMap(Map1, Map2, func(X, Y V) bool { return X == Y })
+}
+
+// WhichKeyValuePairsNotInMapTol checks which key-value pairs from one map are not in another map.
+// It works with maps of any comparable key type and any float value type.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+// When Map1 is empty, the function returns an empty map and false. When Map2 is empty, all key-value pairs of Map1 are missing.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func WhichKeyValuePairsNotInMapTol[K comparable, V Float](Map1, Map2 map[K]V, Tol Tolerance) (map[K]PairMismatch[V], bool) {
+	return whichKeyValuePairsNotInMap(Map1, Map2, func(X, Y V) bool { return isWithinTolerance(X, Y, Tol) })
+}
+
+// WhichKeyValuePairsNotInMapFloat checks which key-value pairs from one map are not in another map.
+// It works with maps of any comparable key type and any float value type.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+// When Map1 is empty, the function returns an empty map and false. When Map2 is empty, all key-value pairs of Map1 are missing.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func WhichKeyValuePairsNotInMapFloat[K comparable, V Float](Map1, Map2 map[K]V, Epsilon float64) (map[K]PairMismatch[V], bool) {
+	return WhichKeyValuePairsNotInMapTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// whichKeyValuePairsNotInMap finds the key-value pairs from Map1 that are not in Map2, comparing values with the equal function.
+func whichKeyValuePairsNotInMap[K comparable, V any](Map1, Map2 map[K]V, equal func(X, Y V) bool) (map[K]PairMismatch[V], bool) {
+	pairs := make(map[K]PairMismatch[V])
 	for key, valueMap1 := range Map1 {
 		valueMap2, ok := Map2[key]
 		if !ok {
-			return false
+			pairs[key] = PairMismatch[V]{Value: valueMap1, KeyAbsent: true}
+			continue
 		}
-		if math.Abs(valueMap1-valueMap2) > Epsilon {
-			return false
+		if !equal(valueMap1, valueMap2) {
+			pairs[key] = PairMismatch[V]{Value: valueMap1, OtherValue: valueMap2}
 		}
 	}
-	return true
+	return pairs, len(pairs) > 0
+}
+
+// AllKeyValuePairsInMapStringString checks if all key-value pairs from one map[string]string are in another map[string]string.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringString(Map1, Map2 map[string]string) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringString checks if any key-value pair from one map[string]string is in another map[string]string.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringString(Map1, Map2 map[string]string) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringString checks which key-value pairs from one map[string]string is in another map[string]string.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+// When any of the maps is empty, the function returns empty slice and false.
+func WhichKeyValuePairsInMapStringString(Map1, Map2 map[string]string) (map[string]string, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringString checks which key-value pairs from one map[string]string are not in another map[string]string.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringString(Map1, Map2 map[string]string) (map[string]PairMismatch[string], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// AllKeyValuePairsInMapStringInt checks if all key-value pairs from one map[string]int are in another map[string]int.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringInt checks if any key-value pair from one map[string]int is in another map[string]int.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringInt(Map1, Map2 map[string]int) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringInt checks which key-value pair from one map[string]int is in another map[string]int.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+// When any of the maps is empty, the function returns empty slice and false.
+func WhichKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) (map[string]int, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringInt checks which key-value pairs from one map[string]int are not in another map[string]int.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringInt(Map1, Map2 map[string]int) (map[string]PairMismatch[int], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// AllKeyValuePairsInMapStringFloat64 checks if all key-value pairs from one map[string]float64 are in another map[string]float64.
+// When any of the maps is empty, the function returns false.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AllKeyValuePairsInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
 }
 
 // AnyKeyValuePairInMapStringFloat64 checks if any key-value pair from one map[string]float64 is in another map[string]float64.
 // When any of the maps is empty, the function returns false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func AnyKeyValuePairInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if math.Abs(valueMap1-valueMap2) <= Epsilon {
-				return true
-			}
-		}
-	}
-	return false
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
 }
 
 // WhichKeyValuePairsInMapStringFloat64 checks if any key-value pair from one map[string]float64 is in another map[string]float64.
@@ -158,173 +269,78 @@ func AnyKeyValuePairInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon fl
 // When any of the maps is empty, the function returns empty slice and false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func WhichKeyValuePairsInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) (map[string]float64, bool) {
-	keys := make(map[string]float64)
-	var exists bool
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
 
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return keys, false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if math.Abs(valueMap1-valueMap2) <= Epsilon {
-				exists = true
-				keys[key] = valueMap1
-			}
-		}
-	}
-	return keys, exists
+// WhichKeyValuePairsNotInMapStringFloat64 checks which key-value pairs from one map[string]float64 are not in another map[string]float64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func WhichKeyValuePairsNotInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) (map[string]PairMismatch[float64], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
 }
 
 // AllKeyValuePairsInMapIntString checks if all key-value pairs from one map[int]string are in another map[int]string.
 // When any of the maps is empty, the function returns false.
 func AllKeyValuePairsInMapIntString(Map1, Map2 map[int]string) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if !ok {
-			return false
-		}
-		if valueMap1 != valueMap2 {
-			return false
-		}
-	}
-	return true
+	return AllKeyValuePairsInMap(Map1, Map2)
 }
 
 // AnyKeyValuePairInMapIntString checks if any key-value pair from one map[int]string is in another map[int]string.
 // When any of the maps is empty, the function returns false.
 func AnyKeyValuePairInMapIntString(Map1, Map2 map[int]string) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if valueMap1 == valueMap2 {
-				return true
-			}
-		}
-	}
-	return false
+	return AnyKeyValuePairInMap(Map1, Map2)
 }
 
 // WhichKeyValuePairsInMapIntString checks if any key-value pair from one map[int]string is in another map[int]string.
 // Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
 // When any of the maps is empty, the function returns empty slice and false.
 func WhichKeyValuePairsInMapIntString(Map1, Map2 map[int]string) (map[int]string, bool) {
-	keys := make(map[int]string)
-	var exists bool
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
 
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return keys, false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if valueMap1 == valueMap2 {
-				exists = true
-				keys[key] = valueMap1
-			}
-		}
-	}
-	return keys, exists
+// WhichKeyValuePairsNotInMapIntString checks which key-value pairs from one map[int]string are not in another map[int]string.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntString(Map1, Map2 map[int]string) (map[int]PairMismatch[string], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
 }
 
 // AllKeyValuePairsInMapIntInt checks if all key-value pairs from one map[int]int are in another map[int]int.
 // When any of the maps is empty, the function returns false.
 func AllKeyValuePairsInMapIntInt(Map1, Map2 map[int]int) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if !ok {
-			return false
-		}
-		if valueMap1 != valueMap2 {
-			return false
-		}
-	}
-	return true
+	return AllKeyValuePairsInMap(Map1, Map2)
 }
 
 // AnyKeyValuePairInMapIntInt checks if any key-value pair from one map[int]int is in another map[int]int.
 // When any of the maps is empty, the function returns false.
 func AnyKeyValuePairInMapIntInt(Map1, Map2 map[int]int) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if valueMap1 == valueMap2 {
-				return true
-			}
-		}
-	}
-	return false
+	return AnyKeyValuePairInMap(Map1, Map2)
 }
 
 // WhichKeyValuePairsInMapIntInt checks if any key-value pair from one map[int]int is in another map[int]int.
 // Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
 // When any of the maps is empty, the function returns empty slice and false.
 func WhichKeyValuePairsInMapIntInt(Map1, Map2 map[int]int) (map[int]int, bool) {
-	keys := make(map[int]int)
-	var exists bool
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
 
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return keys, false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if valueMap1 == valueMap2 {
-				exists = true
-				keys[key] = valueMap1
-			}
-		}
-	}
-	return keys, exists
+// WhichKeyValuePairsNotInMapIntInt checks which key-value pairs from one map[int]int are not in another map[int]int.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntInt(Map1, Map2 map[int]int) (map[int]PairMismatch[int], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
 }
 
 // AllKeyValuePairsInMapIntFloat64 checks if all key-value pairs from one map[int]float64 are in another map[int]float64.
 // When any of the maps is empty, the function returns false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func AllKeyValuePairsInMapIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if !ok {
-			return false
-		}
-		if math.Abs(valueMap1-valueMap2) > Epsilon {
-			return false
-		}
-	}
-	return true
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
 }
 
 // AnyKeyValuePairInMapIntFloat64 checks if any key-value pair from one map[int]float64 is in another map[int]float64.
 // When any of the maps is empty, the function returns false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func AnyKeyValuePairInMapIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) bool {
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if math.Abs(valueMap1-valueMap2) <= Epsilon {
-				return true
-			}
-		}
-	}
-	return false
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
 }
 
 // WhichKeyValuePairsInMapIntFloat64 checks if any key-value pair from one map[int]float64 is in another map[int]float64.
@@ -332,20 +348,62 @@ func AnyKeyValuePairInMapIntFloat64(Map1, Map2 map[int]float64, Epsilon float64)
 // When any of the maps is empty, the function returns empty slice and false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func WhichKeyValuePairsInMapIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) (map[int]float64, bool) {
-	keys := make(map[int]float64)
-	var exists bool
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
 
-	if len(Map1) == 0 || len(Map2) == 0 {
-		return keys, false
-	}
-	for key, valueMap1 := range Map1 {
-		valueMap2, ok := Map2[key]
-		if ok {
-			if math.Abs(valueMap1-valueMap2) <= Epsilon {
-				exists = true
-				keys[key] = valueMap1
-			}
-		}
-	}
-	return keys, exists
+// WhichKeyValuePairsNotInMapIntFloat64 checks which key-value pairs from one map[int]float64 are not in another map[int]float64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func WhichKeyValuePairsNotInMapIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) (map[int]PairMismatch[float64], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AllKeyValuePairsInMapStringBool checks if all key-value pairs from one map[string]bool are in another map[string]bool.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringBool(Map1, Map2 map[string]bool) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringBool checks if any key-value pair from one map[string]bool is in another map[string]bool.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringBool(Map1, Map2 map[string]bool) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringBool checks which key-value pairs from one map[string]bool is in another map[string]bool.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+// When any of the maps is empty, the function returns empty slice and false.
+func WhichKeyValuePairsInMapStringBool(Map1, Map2 map[string]bool) (map[string]bool, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringBool checks which key-value pairs from one map[string]bool are not in another map[string]bool.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringBool(Map1, Map2 map[string]bool) (map[string]PairMismatch[bool], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// AllKeyValuePairsInMapIntBool checks if all key-value pairs from one map[int]bool are in another map[int]bool.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntBool(Map1, Map2 map[int]bool) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntBool checks if any key-value pair from one map[int]bool is in another map[int]bool.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntBool(Map1, Map2 map[int]bool) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntBool checks which key-value pairs from one map[int]bool is in another map[int]bool.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+// When any of the maps is empty, the function returns empty slice and false.
+func WhichKeyValuePairsInMapIntBool(Map1, Map2 map[int]bool) (map[int]bool, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntBool checks which key-value pairs from one map[int]bool are not in another map[int]bool.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntBool(Map1, Map2 map[int]bool) (map[int]PairMismatch[bool], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
 }
@@ -710,3 +710,126 @@ func ExampleWhichKeyValuePairsInMapIntFloat64() {
 	// false map[]
 	// true map[1:0.11]
 }
+
+func TestAllKeyValuePairsInMap(t *testing.T) {
+	tests := []struct {
+		map1     map[int64]bool
+		map2     map[int64]bool
+		expected bool
+	}{
+		{map[int64]bool{}, map[int64]bool{1: true}, false},
+		{map[int64]bool{1: true}, map[int64]bool{}, false},
+		{map[int64]bool{1: true}, map[int64]bool{1: true, 2: false}, true},
+		{map[int64]bool{1: true, 3: true}, map[int64]bool{1: true, 2: false}, false},
+		{map[int64]bool{1: false}, map[int64]bool{1: true, 2: false}, false},
+	}
+	for _, test := range tests {
+		if AllKeyValuePairsInMap(test.map1, test.map2) != test.expected {
+			t.Errorf("AllKeyValuePairsInMap(%v, %v) should be %v", test.map1, test.map2, test.expected)
+		}
+	}
+}
+
+func TestAnyKeyValuePairInMap(t *testing.T) {
+	tests := []struct {
+		map1     map[string]uint
+		map2     map[string]uint
+		expected bool
+	}{
+		{map[string]uint{}, map[string]uint{"a": 1}, false},
+		{map[string]uint{"a": 1, "b": 2}, map[string]uint{"a": 1}, true},
+		{map[string]uint{"a": 2, "b": 2}, map[string]uint{"a": 1}, false},
+	}
+	for _, test := range tests {
+		if AnyKeyValuePairInMap(test.map1, test.map2) != test.expected {
+			t.Errorf("AnyKeyValuePairInMap(%v, %v) should be %v", test.map1, test.map2, test.expected)
+		}
+	}
+}
+
+func TestWhichKeyValuePairsInMap(t *testing.T) {
+	pairs, ok := WhichKeyValuePairsInMap(map[string]uint{"a": 1, "b": 2, "c": 3}, map[string]uint{"a": 1, "b": 3})
+	if !ok || !AreEqualMaps(pairs, map[string]uint{"a": 1}) {
+		t.Errorf("WhichKeyValuePairsInMap returned %v, %v; want map[a:1], true", pairs, ok)
+	}
+	pairs, ok = WhichKeyValuePairsInMap(map[string]uint{"a": 1}, map[string]uint{})
+	if ok || len(pairs) != 0 {
+		t.Errorf("WhichKeyValuePairsInMap returned %v, %v; want map[], false", pairs, ok)
+	}
+}
+
+func TestKeyValuePairsInMapFloat(t *testing.T) {
+	map1 := map[int64]float32{1: 1, 2: 2}
+	map2 := map[int64]float32{1: 1.05, 2: 3}
+	if AllKeyValuePairsInMapFloat(map1, map2, .1) {
+		t.Errorf("AllKeyValuePairsInMapFloat(%v, %v, .1) should be false", map1, map2)
+	}
+	if !AllKeyValuePairsInMapFloat(map1, map2, 1) {
+		t.Errorf("AllKeyValuePairsInMapFloat(%v, %v, 1) should be true", map1, map2)
+	}
+	if !AnyKeyValuePairInMapFloat(map1, map2, .1) {
+		t.Errorf("AnyKeyValuePairInMapFloat(%v, %v, .1) should be true", map1, map2)
+	}
+	pairs, ok := WhichKeyValuePairsInMapFloat(map1, map2, .1)
+	if !ok || !AreEqualMaps(pairs, map[int64]float32{1: 1}) {
+		t.Errorf("WhichKeyValuePairsInMapFloat(%v, %v, .1) returned %v, %v; want map[1:1], true", map1, map2, pairs, ok)
+	}
+}
+
+func ExampleAllKeyValuePairsInMap() {
+	required := map[string]bool{"tls": true}
+	config := map[string]bool{"tls": true, "debug": false}
+	fmt.Println(AllKeyValuePairsInMap(required, config))
+	// Output:
+	// true
+}
+
+func TestWhichKeyValuePairsNotInMapStringString(t *testing.T) {
+	map1 := map[string]string{"region": "eu", "zone": "a", "tier": "gold"}
+	map2 := map[string]string{"region": "us", "tier": "gold"}
+	actual, ok := WhichKeyValuePairsNotInMapStringString(map1, map2)
+	expected := map[string]PairMismatch[string]{
+		"region": {Value: "eu", OtherValue: "us"},
+		"zone":   {Value: "a", KeyAbsent: true},
+	}
+	if len(actual) != len(expected) || !ok {
+		t.Errorf("WhichKeyValuePairsNotInMapStringString(%v, %v) = %v, %v; want %v, true", map1, map2, actual, ok, expected)
+	}
+	for key, mismatch := range expected {
+		if actual[key] != mismatch {
+			t.Errorf("WhichKeyValuePairsNotInMapStringString(%v, %v)[%v] = %+v; want %+v", map1, map2, key, actual[key], mismatch)
+		}
+	}
+	if actual, ok := WhichKeyValuePairsNotInMapStringString(map[string]string{}, map2); len(actual) != 0 || ok {
+		t.Errorf("WhichKeyValuePairsNotInMapStringString(map[], %v) = %v, %v; want map[], false", map2, actual, ok)
+	}
+}
+
+func ExampleWhichKeyValuePairsNotInMapStringInt() {
+	missing, ok := WhichKeyValuePairsNotInMapStringInt(map[string]int{"a": 1, "b": 2, "c": 3}, map[string]int{"a": 1, "b": 5})
+	fmt.Printf("%+v\n", missing["b"])
+	fmt.Printf("%+v\n", missing["c"])
+	fmt.Println(len(missing), ok)
+	// Output:
+	// {Value:2 OtherValue:5 KeyAbsent:false}
+	// {Value:3 OtherValue:0 KeyAbsent:true}
+	// 2 true
+}
+
+func TestWhichKeyValuePairsNotInMapTypes(t *testing.T) {
+	if actual, ok := WhichKeyValuePairsNotInMapStringFloat64(map[string]float64{"a": .1, "b": .2}, map[string]float64{"a": .105}, .01); len(actual) != 1 || !ok || !actual["b"].KeyAbsent {
+		t.Errorf("WhichKeyValuePairsNotInMapStringFloat64() = %v, %v; want b absent", actual, ok)
+	}
+	if actual, ok := WhichKeyValuePairsNotInMapIntString(map[int]string{1: "a"}, map[int]string{1: "b"}); len(actual) != 1 || !ok || actual[1].OtherValue != "b" {
+		t.Errorf("WhichKeyValuePairsNotInMapIntString() = %v, %v; want 1 with a different value", actual, ok)
+	}
+	if actual, ok := WhichKeyValuePairsNotInMapIntInt(map[int]int{1: 1}, map[int]int{}); len(actual) != 1 || !ok || !actual[1].KeyAbsent {
+		t.Errorf("WhichKeyValuePairsNotInMapIntInt() = %v, %v; want 1 absent", actual, ok)
+	}
+	if actual, ok := WhichKeyValuePairsNotInMapIntFloat64(map[int]float64{1: .1}, map[int]float64{1: .2}, .01); len(actual) != 1 || !ok || actual[1].OtherValue != .2 {
+		t.Errorf("WhichKeyValuePairsNotInMapIntFloat64() = %v, %v; want 1 with a different value", actual, ok)
+	}
+	if actual, ok := WhichKeyValuePairsNotInMapIntFloat64(map[int]float64{1: .1}, map[int]float64{1: .105}, .01); len(actual) != 0 || ok {
+		t.Errorf("WhichKeyValuePairsNotInMapIntFloat64() = %v, %v; want map[], false", actual, ok)
+	}
+}
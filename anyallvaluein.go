@@ -1,12 +1,10 @@
 package check
 
-import (
-	"math"
-)
+import "math"
 
-// AllValuesInIntSlice checks if all values of one int slice are in another slice.
+// AllValuesIn checks if all values of one slice are in another slice. It works with slices of any comparable type.
 // When the first slice is empty, it returns true. When the second slice is empty, it returns false.
-func AllValuesInIntSlice(Slice1, Slice2 []int) bool {
+func AllValuesIn[T comparable](Slice1, Slice2 []T) bool {
 	if len(Slice1) == 0 {
 		return true
 	}
@@ -14,16 +12,17 @@ func AllValuesInIntSlice(Slice1, Slice2 []int) bool {
 		return false
 	}
 	for _, x := range Slice1 {
-		if !IsValueInIntSlice(x, Slice2) {
+		if !IsValueIn(x, Slice2) {
 			return false
 		}
 	}
 	return true
 }
 
-// AllValuesInStringSlice checks if all values of one string slice are in another slice.
+// AllValuesInTol checks if all values of one float slice are in another slice. It works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
 // When the first slice is empty, it returns true. When the second slice is empty, it returns false.
-func AllValuesInStringSlice(Slice1, Slice2 []string) bool {
+func AllValuesInTol[T Float](Slice1, Slice2 []T, Tol Tolerance) bool {
 	if len(Slice1) == 0 {
 		return true
 	}
@@ -31,245 +30,297 @@ func AllValuesInStringSlice(Slice1, Slice2 []string) bool {
 		return false
 	}
 	for _, x := range Slice1 {
-		if !IsValueInStringSlice(x, Slice2) {
+		if !IsValueInTol(x, Slice2, Tol) {
 			return false
 		}
 	}
 	return true
 }
 
-// AllValuesInFloat64Slice checks if all values of one float64 slice are in another slice.
+// AllValuesInFloat checks if all values of one float slice are in another slice. It works with slices of any float type.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // When the first slice is empty, it returns true. When the second slice is empty, it returns false.
-func AllValuesInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) bool {
-	if len(Slice1) == 0 {
-		return true
-	}
-	if len(Slice2) == 0 {
+func AllValuesInFloat[T Float](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AllValuesInTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// AnyValueIn checks if any of the values of one slice is in another slice. It works with slices of any comparable type.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueIn[T comparable](Slice1, Slice2 []T) bool {
+	if len(Slice1) == 0 || len(Slice2) == 0 {
 		return false
 	}
 	for _, x := range Slice1 {
-		if !IsValueInFloat64Slice(x, Slice2, Epsilon) {
-			return false
+		if IsValueIn(x, Slice2) {
+			return true
 		}
 	}
-	return true
+	return false
 }
 
-// AnyValueInIntSlice checks if any of the values of one int slice is in another slice.
+// AnyValueInTol checks if any of the values of one float slice is in another slice. It works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
 // When the first or the second (or both) slice is empty, it returns false.
-func AnyValueInIntSlice(Slice1, Slice2 []int) bool {
+func AnyValueInTol[T Float](Slice1, Slice2 []T, Tol Tolerance) bool {
 	if len(Slice1) == 0 || len(Slice2) == 0 {
 		return false
 	}
 	for _, x := range Slice1 {
-		if IsValueInIntSlice(x, Slice2) {
+		if IsValueInTol(x, Slice2, Tol) {
 			return true
 		}
 	}
 	return false
 }
 
-// WhichValuesInIntSlice checks which values of one int slice are in another slice.
+// AnyValueInFloat checks if any of the values of one float slice is in another slice. It works with slices of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInFloat[T Float](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AnyValueInTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// WhichValuesIn checks which values of one slice are in another slice. It works with slices of any comparable type.
 // When the first or the second (or both) slice is empty, it returns false and an empty map.
 // The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
 // and a boolean value (true if the returned map is not empty).
-func WhichValuesInIntSlice(Slice1, Slice2 []int) (map[int][]int, bool) {
-	values := make(map[int][]int)
+func WhichValuesIn[T comparable](Slice1, Slice2 []T) (map[T][]int, bool) {
+	values := make(map[T][]int)
 	if len(Slice1) == 0 || len(Slice2) == 0 {
 		return values, false
 	}
 
 	// Remove dulpicated elements from Slice 1 (not from Slice 2!)
-	if !IsUniqueIntSlice(Slice1) {
-		Slice1 = UniqueIntSlice(Slice1)
+	if !IsUniqueSlice(Slice1) {
+		Slice1 = UniqueSlice(Slice1)
 	}
 
 	for _, valueInSlice1 := range Slice1 {
-		if IsValueInIntSlice(valueInSlice1, Slice2) {
-			for index, valueInSlice2 := range Slice2 {
-				if valueInSlice1 == valueInSlice2 && !IsValueInIntSlice(index, values[valueInSlice1]) {
-					values[valueInSlice1] = append(values[valueInSlice1], index)
-				}
+		for index, valueInSlice2 := range Slice2 {
+			if valueInSlice1 == valueInSlice2 {
+				values[valueInSlice1] = append(values[valueInSlice1], index)
 			}
 		}
 	}
 	return values, len(values) > 0
 }
 
-// AnyValueInStringSlice checks if any of the values of one string slice is in another slice.
-// When the first or the second (or both) slice is empty, it returns false.
-func AnyValueInStringSlice(Slice1, Slice2 []string) bool {
-	if len(Slice1) == 0 || len(Slice2) == 0 {
-		return false
-	}
-	for _, x := range Slice1 {
-		if IsValueInStringSlice(x, Slice2) {
-			return true
-		}
-	}
-	return false
-}
-
-// WhichValuesInStringSlice checks which values of one string slice are in another slice.
-// When the first or the second (or both) slice is empty, it returns false.
+// WhichValuesInTol checks which values of one float slice are in another slice. It works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// When the first or the second (or both) slice is empty, it returns false and an empty map.
 // The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
 // and a boolean value (true if the returned map is not empty).
-func WhichValuesInStringSlice(Slice1, Slice2 []string) (map[string][]int, bool) {
-	values := make(map[string][]int)
+// Note that a NaN key (possible with Tol.Policy.NaNEqualsNaN) can be reached only by ranging over the returned map,
+// and that -0 and +0 are the same map key.
+func WhichValuesInTol[T Float](Slice1, Slice2 []T, Tol Tolerance) (map[T][]int, bool) {
+	values := make(map[T][]int)
 	if len(Slice1) == 0 || len(Slice2) == 0 {
 		return values, false
 	}
 
 	// Remove dulpicated elements from Slice 1 (not from Slice 2!)
-	if !IsUniqueStringSlice(Slice1) {
-		Slice1 = UniqueStringSlice(Slice1)
+	if !IsUniqueSliceTol(Slice1, Tol) {
+		Slice1 = UniqueSliceTol(Slice1, Tol)
 	}
 
 	for _, valueInSlice1 := range Slice1 {
-		if IsValueInStringSlice(valueInSlice1, Slice2) {
-			for index, valueInSlice2 := range Slice2 {
-				if valueInSlice1 == valueInSlice2 && !IsValueInIntSlice(index, values[valueInSlice1]) {
-					values[valueInSlice1] = append(values[valueInSlice1], index)
-				}
+		// NaN is never equal to itself as a map key, so the indices are collected before being assigned.
+		var indices []int
+		for index, valueInSlice2 := range Slice2 {
+			if isWithinTolerance(valueInSlice1, valueInSlice2, Tol) {
+				indices = append(indices, index)
 			}
 		}
-	}
-	return values, len(values) > 0
-}
-
-// AnyValueInFloat64Slice checks if any of the values of one float64 slice is in another slice.
-// The Epsilon parameter sets the accuracy of the comparison of two floats.
-// When the first or the second (or both) slice is empty, it returns false.
-func AnyValueInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) bool {
-	if len(Slice1) == 0 || len(Slice2) == 0 {
-		return false
-	}
-	for _, x := range Slice1 {
-		if IsValueInFloat64Slice(x, Slice2, Epsilon) {
-			return true
+		if len(indices) > 0 {
+			values[valueInSlice1] = indices
 		}
 	}
-	return false
+	return values, len(values) > 0
 }
 
-// WhichValuesInFloat64Slice checks which values of one float64 slice are in another slice.
+// WhichValuesInFloat checks which values of one float slice are in another slice. It works with slices of any float type.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
-// When the first or the second (or both) slice is empty, it returns false.
-// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,//
+// When the first or the second (or both) slice is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
 // and a boolean value (true if the returned map is not empty).
-func WhichValuesInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) (map[float64][]int, bool) {
-	values := make(map[float64][]int)
-	if len(Slice1) == 0 || len(Slice2) == 0 {
-		return values, false
-	}
+func WhichValuesInFloat[T Float](Slice1, Slice2 []T, Epsilon float64) (map[T][]int, bool) {
+	return WhichValuesInTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
 
-	// Remove dulpicated elements from Slice 1 (not from Slice 2!)
-	if !IsUniqueFloat64Slice(Slice1, Epsilon) {
-		Slice1 = UniqueFloat64Slice(Slice1, Epsilon)
+// WhichValuesNotIn checks which values of one slice are not in another slice. It works with slices of any comparable type.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+// When the first slice is empty, it returns false and an empty map. When the second slice is empty, all values of the first slice are missing.
+func WhichValuesNotIn[T comparable](Slice1, Slice2 []T) (map[T][]int, bool) {
+	idx := NewIndex(Slice2)
+	values := make(map[T][]int)
+	for index, value := range Slice1 {
+		if !idx.IsValueIn(value) {
+			values[value] = append(values[value], index)
+		}
 	}
+	return values, len(values) > 0
+}
 
-	for _, valueInSlice1 := range Slice1 {
-		if IsValueInFloat64Slice(valueInSlice1, Slice2, Epsilon) {
-			for index, valueInSlice2 := range Slice2 {
-				if math.Abs(valueInSlice1-valueInSlice2) <= Epsilon && !IsValueInIntSlice(index, values[valueInSlice1]) {
-					values[valueInSlice1] = append(values[valueInSlice1], index)
-				}
-			}
+// WhichValuesNotInTol checks which values of one float slice are not in another slice. It works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+// When the first slice is empty, it returns false and an empty map. When the second slice is empty, all values of the first slice are missing.
+// Note that a NaN key (missing unless Tol.Policy.NaNEqualsNaN is used) can be reached only by ranging over the returned map,
+// and that -0 and +0 are the same map key.
+func WhichValuesNotInTol[T Float](Slice1, Slice2 []T, Tol Tolerance) (map[T][]int, bool) {
+	values := make(map[T][]int)
+	var nanIndices []int
+	for index, value := range Slice1 {
+		if IsValueInTol(value, Slice2, Tol) {
+			continue
+		}
+		// NaN is never equal to itself as a map key, so its indices are collected before being assigned.
+		if value != value {
+			nanIndices = append(nanIndices, index)
+			continue
 		}
+		values[value] = append(values[value], index)
+	}
+	if len(nanIndices) > 0 {
+		values[T(math.NaN())] = nanIndices
 	}
 	return values, len(values) > 0
 }
 
-// AnyValueInMapIntInt checks if any of the values of an int slice is a value of map[int]int.
+// WhichValuesNotInFloat checks which values of one float slice are not in another slice. It works with slices of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+// When the first slice is empty, it returns false and an empty map. When the second slice is empty, all values of the first slice are missing.
+func WhichValuesNotInFloat[T Float](Slice1, Slice2 []T, Epsilon float64) (map[T][]int, bool) {
+	return WhichValuesNotInTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// AnyValueInMap checks if any of the values of a slice is a value of a map. It works with maps of any comparable key and value types.
 // When either the slice or the map is empty, it returns false.
-func AnyValueInMapIntInt(Slice []int, Map map[int]int) bool {
+func AnyValueInMap[K comparable, V comparable](Slice []V, Map map[K]V) bool {
 	if len(Slice) == 0 || len(Map) == 0 {
 		return false
 	}
 	for _, x := range Slice {
-		if _, ok := IsValueInMapIntInt(x, Map); ok {
+		if _, ok := IsValueInMap(x, Map); ok {
 			return true
 		}
 	}
 	return false
 }
 
-// AllValuesInMapIntInt checks if all values of an int slice are values of map[int]int.
+// AnyValueInMapTol checks if any of the values of a float slice is a value of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
 // When either the slice or the map is empty, it returns false.
-func AllValuesInMapIntInt(Slice []int, Map map[int]int) bool {
+func AnyValueInMapTol[K comparable, V Float](Slice []V, Map map[K]V, Tol Tolerance) bool {
 	if len(Slice) == 0 || len(Map) == 0 {
 		return false
 	}
 	for _, x := range Slice {
-		if _, ok := IsValueInMapIntInt(x, Map); !ok {
-			return false
+		if _, ok := IsValueInMapTol(x, Map, Tol); ok {
+			return true
 		}
 	}
-	return true
+	return false
 }
 
-// WhichValuesInMapIntInt checks which values of an int slice are values of map[int]int.
+// AnyValueInMapFloat checks if any of the values of a float slice is a value of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
 // When either the slice or the map is empty, it returns false.
-// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
-// and a boolean value (true if the returned map is not empty).
-func WhichValuesInMapIntInt(Slice []int, Map map[int]int) (map[int][]int, bool) {
-	values := make(map[int][]int)
-	var exists bool
+func AnyValueInMapFloat[K comparable, V Float](Slice []V, Map map[K]V, Epsilon float64) bool {
+	return AnyValueInMapTol(Slice, Map, AbsTolerance(Epsilon))
+}
 
+// AllValuesInMap checks if all values of a slice are values of a map. It works with maps of any comparable key and value types.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMap[K comparable, V comparable](Slice []V, Map map[K]V) bool {
 	if len(Slice) == 0 || len(Map) == 0 {
-		return map[int][]int{}, false
+		return false
 	}
 	for _, x := range Slice {
-		if keys, ok := IsValueInMapIntInt(x, Map); ok {
-			exists = true
-			values[x] = keys
+		if _, ok := IsValueInMap(x, Map); !ok {
+			return false
 		}
 	}
-	return values, exists
+	return true
 }
 
-// AnyValueInMapIntString checks if any of the values of a string slice is a value of map[int]string.
+// AllValuesInMapTol checks if all values of a float slice are values of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
 // When either the slice or the map is empty, it returns false.
-func AnyValueInMapIntString(Slice []string, Map map[int]string) bool {
+func AllValuesInMapTol[K comparable, V Float](Slice []V, Map map[K]V, Tol Tolerance) bool {
 	if len(Slice) == 0 || len(Map) == 0 {
 		return false
 	}
 	for _, x := range Slice {
-		if _, ok := IsValueInMapIntString(x, Map); ok {
-			return true
+		if _, ok := IsValueInMapTol(x, Map, Tol); !ok {
+			return false
 		}
 	}
-	return false
+	return true
 }
 
-// AllValuesInMapIntString checks if all values of a string slice are in values of map[int]string.
+// AllValuesInMapFloat checks if all values of a float slice are values of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
 // When either the slice or the map is empty, it returns false.
-func AllValuesInMapIntString(Slice []string, Map map[int]string) bool {
+func AllValuesInMapFloat[K comparable, V Float](Slice []V, Map map[K]V, Epsilon float64) bool {
+	return AllValuesInMapTol(Slice, Map, AbsTolerance(Epsilon))
+}
+
+// WhichValuesInMap checks which values of a slice are values of a map. It works with maps of any comparable key and value types.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty).
+// The keys are in no particular order; use WhichValuesInMapSorted to get them sorted.
+func WhichValuesInMap[K comparable, V comparable](Slice []V, Map map[K]V) (map[V][]K, bool) {
+	values := make(map[V][]K)
+	var exists bool
+
 	if len(Slice) == 0 || len(Map) == 0 {
-		return false
+		return map[V][]K{}, false
 	}
 	for _, x := range Slice {
-		if _, ok := IsValueInMapIntString(x, Map); !ok {
-			return false
+		if keys, ok := IsValueInMap(x, Map); ok {
+			exists = true
+			values[x] = keys
 		}
 	}
-	return true
+	return values, exists
 }
 
-// WhichValuesInMapIntString checks which values of a string slice are values of map[int]string.
+// WhichValuesInMapTol checks which values of a float slice are values of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
 // When either the slice or the map is empty, it returns false.
 // The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
 // and a boolean value (true if the returned map is not empty).
-func WhichValuesInMapIntString(Slice []string, Map map[int]string) (map[string][]int, bool) {
-	values := make(map[string][]int)
+// BEWARE! Do note that we work with floats, so it's safest to round them before using this function,
+// since they will be keys of a returned map.
+// The keys are in no particular order; use WhichValuesInMapSortedTol to get them sorted.
+func WhichValuesInMapTol[K comparable, V Float](Slice []V, Map map[K]V, Tol Tolerance) (map[V][]K, bool) {
+	values := make(map[V][]K)
 	var exists bool
 
 	if len(Slice) == 0 || len(Map) == 0 {
-		return map[string][]int{}, false
+		return map[V][]K{}, false
 	}
+	var nanFound bool
 	for _, x := range Slice {
-		if keys, ok := IsValueInMapIntString(x, Map); ok {
+		// NaN is never equal to itself as a map key, so it is added only once.
+		if x != x {
+			if nanFound {
+				continue
+			}
+			nanFound = true
+		}
+		if keys, ok := IsValueInMapTol(x, Map, Tol); ok {
 			exists = true
 			values[x] = keys
 		}
@@ -277,33 +328,244 @@ func WhichValuesInMapIntString(Slice []string, Map map[int]string) (map[string][
 	return values, exists
 }
 
+// WhichValuesInMapFloat checks which values of a float slice are values of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty).
+// BEWARE! Do note that we work with floats, so it's safest to round them before using this function,
+// since they will be keys of a returned map.
+// The keys are in no particular order; use WhichValuesInMapSortedFloat to get them sorted.
+func WhichValuesInMapFloat[K comparable, V Float](Slice []V, Map map[K]V, Epsilon float64) (map[V][]K, bool) {
+	return WhichValuesInMapTol(Slice, Map, AbsTolerance(Epsilon))
+}
+
+// WhichValuesInMapSorted checks which values of a slice are values of a map. It works with maps of any ordered key type and any comparable value type.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// sorted in increasing order, and a boolean value (true if the returned map is not empty).
+func WhichValuesInMapSorted[K Ordered, V comparable](Slice []V, Map map[K]V) (map[V][]K, bool) {
+	values, exists := WhichValuesInMap(Slice, Map)
+	for _, keys := range values {
+		sortKeys(keys)
+	}
+	return values, exists
+}
+
+// WhichValuesInMapSortedTol checks which values of a float slice are values of a map.
+// It works with maps of any ordered key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// sorted in increasing order, and a boolean value (true if the returned map is not empty).
+func WhichValuesInMapSortedTol[K Ordered, V Float](Slice []V, Map map[K]V, Tol Tolerance) (map[V][]K, bool) {
+	values, exists := WhichValuesInMapTol(Slice, Map, Tol)
+	for _, keys := range values {
+		sortKeys(keys)
+	}
+	return values, exists
+}
+
+// WhichValuesInMapSortedFloat checks which values of a float slice are values of a map.
+// It works with maps of any ordered key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// sorted in increasing order, and a boolean value (true if the returned map is not empty).
+func WhichValuesInMapSortedFloat[K Ordered, V Float](Slice []V, Map map[K]V, Epsilon float64) (map[V][]K, bool) {
+	return WhichValuesInMapSortedTol(Slice, Map, AbsTolerance(Epsilon))
+}
+
+// WhichValuesNotInMap checks which values of a slice are not values of a map. It works with maps of any comparable key and value types.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+// When the slice is empty, it returns false and an empty map. When the map is empty, all values of the slice are missing.
+func WhichValuesNotInMap[K comparable, V comparable](Slice []V, Map map[K]V) (map[V][]int, bool) {
+	mapValues := make([]V, 0, len(Map))
+	for _, value := range Map {
+		mapValues = append(mapValues, value)
+	}
+	return WhichValuesNotIn(Slice, mapValues)
+}
+
+// WhichValuesNotInMapTol checks which values of a float slice are not values of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+// When the slice is empty, it returns false and an empty map. When the map is empty, all values of the slice are missing.
+// See WhichValuesNotInTol for NaN and signed zero keys.
+func WhichValuesNotInMapTol[K comparable, V Float](Slice []V, Map map[K]V, Tol Tolerance) (map[V][]int, bool) {
+	mapValues := make([]V, 0, len(Map))
+	for _, value := range Map {
+		mapValues = append(mapValues, value)
+	}
+	return WhichValuesNotInTol(Slice, mapValues, Tol)
+}
+
+// WhichValuesNotInMapFloat checks which values of a float slice are not values of a map.
+// It works with maps of any comparable key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+// When the slice is empty, it returns false and an empty map. When the map is empty, all values of the slice are missing.
+func WhichValuesNotInMapFloat[K comparable, V Float](Slice []V, Map map[K]V, Epsilon float64) (map[V][]int, bool) {
+	return WhichValuesNotInMapTol(Slice, Map, AbsTolerance(Epsilon))
+}
+
+// AllValuesInIntSlice checks if all values of one int slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInIntSlice(Slice1, Slice2 []int) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AllValuesInStringSlice checks if all values of one string slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInStringSlice(Slice1, Slice2 []string) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AllValuesInFloat64Slice checks if all values of one float64 slice are in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) bool {
+	return AllValuesInFloat(Slice1, Slice2, Epsilon)
+}
+
+// AnyValueInIntSlice checks if any of the values of one int slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInIntSlice(Slice1, Slice2 []int) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInIntSlice checks which values of one int slice are in another slice.
+// When the first or the second (or both) slice is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInIntSlice(Slice1, Slice2 []int) (map[int][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInIntSlice checks which values of one int slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInIntSlice(Slice1, Slice2 []int) (map[int][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AnyValueInStringSlice checks if any of the values of one string slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInStringSlice(Slice1, Slice2 []string) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInStringSlice checks which values of one string slice are in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInStringSlice(Slice1, Slice2 []string) (map[string][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInStringSlice checks which values of one string slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInStringSlice(Slice1, Slice2 []string) (map[string][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AnyValueInFloat64Slice checks if any of the values of one float64 slice is in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) bool {
+	return AnyValueInFloat(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesInFloat64Slice checks which values of one float64 slice are in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first or the second (or both) slice is empty, it returns false.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,//
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) (map[float64][]int, bool) {
+	return WhichValuesInFloat(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesNotInFloat64Slice checks which values of one float64 slice are not in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) (map[float64][]int, bool) {
+	return WhichValuesNotInFloat(Slice1, Slice2, Epsilon)
+}
+
+// AnyValueInMapIntInt checks if any of the values of an int slice is a value of map[int]int.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntInt(Slice []int, Map map[int]int) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntInt checks if all values of an int slice are values of map[int]int.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntInt(Slice []int, Map map[int]int) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntInt checks which values of an int slice are values of map[int]int.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty).
+// The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntInt(Slice []int, Map map[int]int) (map[int][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntInt checks which values of an int slice are not values of map[int]int.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntInt(Slice []int, Map map[int]int) (map[int][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AnyValueInMapIntString checks if any of the values of a string slice is a value of map[int]string.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntString(Slice []string, Map map[int]string) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntString checks if all values of a string slice are in values of map[int]string.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntString(Slice []string, Map map[int]string) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntString checks which values of a string slice are values of map[int]string.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty).
+// The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntString(Slice []string, Map map[int]string) (map[string][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntString checks which values of a string slice are not values of map[int]string.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntString(Slice []string, Map map[int]string) (map[string][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
 // AnyValueInMapIntFloat64 checks if any of the values of a float64 slice is in map[int]float64.
 // When either the slice or the map is empty, it returns false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func AnyValueInMapIntFloat64(Slice []float64, Map map[int]float64, Epsilon float64) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapIntFloat64(x, Map, Epsilon); ok {
-			return true
-		}
-	}
-	return false
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
 }
 
 // AllValuesInMapIntFloat64 checks if all values of a float64 slice are values of map[int]float64.
 // When either the slice or the map is empty, it returns false.
 func AllValuesInMapIntFloat64(Slice []float64, Map map[int]float64, Epsilon float64) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapIntFloat64(x, Map, Epsilon); !ok {
-			return false
-		}
-	}
-	return true
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
 }
 
 // WhichValuesInMapIntFloat64 checks which values of a float64 slice are values of map[int]float64.
@@ -314,96 +576,57 @@ func AllValuesInMapIntFloat64(Slice []float64, Map map[int]float64, Epsilon floa
 // since they will be keys of a returned map. Thus, this
 // WhichValuesInMapIntFloat64({[]float64{.01002, .01, .2}, map[int]float64{1: .01, 2: .011}, .0001)
 // will return the following map: map[float64][]int{.01: {1}, .01002: {1}}}
+// The keys in the returned map's slices are sorted in increasing order.
 func WhichValuesInMapIntFloat64(Slice []float64, Map map[int]float64, Epsilon float64) (map[float64][]int, bool) {
-	values := make(map[float64][]int)
-	var exists bool
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
 
-	if len(Slice) == 0 || len(Map) == 0 {
-		return map[float64][]int{}, false
-	}
-	for _, x := range Slice {
-		if keys, ok := IsValueInMapIntFloat64(x, Map, Epsilon); ok {
-			exists = true
-			values[x] = keys
-		}
-	}
-	return values, exists
+// WhichValuesNotInMapIntFloat64 checks which values of a float64 slice are not values of map[int]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntFloat64(Slice []float64, Map map[int]float64, Epsilon float64) (map[float64][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
 }
 
 // AnyValueInMapStringInt checks if any of the values of a string slice is a value of map[string]int.
 // When either the slice or the map is empty, it returns false.
 func AnyValueInMapStringInt(Slice []int, Map map[string]int) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapStringInt(x, Map); ok {
-			return true
-		}
-	}
-	return false
+	return AnyValueInMap(Slice, Map)
 }
 
 // AllValuesInMapStringInt checks if all values of a string slice are values of map[string]int.
 // When either the slice or the map is empty, it returns false.
 func AllValuesInMapStringInt(Slice []int, Map map[string]int) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapStringInt(x, Map); !ok {
-			return false
-		}
-	}
-	return true
+	return AllValuesInMap(Slice, Map)
 }
 
 // WhichValuesInMapStringInt checks which values of an int slice are values of map[string]int.
 // When either the slice or the map is empty, it returns false.
 // The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
 // and a boolean value (true if the returned map is not empty).
+// The keys in the returned map's slices are sorted in increasing order.
 func WhichValuesInMapStringInt(Slice []int, Map map[string]int) (map[int][]string, bool) {
-	values := make(map[int][]string)
-	var exists bool
+	return WhichValuesInMapSorted(Slice, Map)
+}
 
-	if len(Slice) == 0 || len(Map) == 0 {
-		return map[int][]string{}, false
-	}
-	for _, x := range Slice {
-		if keys, ok := IsValueInMapStringInt(x, Map); ok {
-			exists = true
-			values[x] = keys
-		}
-	}
-	return values, exists
+// WhichValuesNotInMapStringInt checks which values of an int slice are not values of map[string]int.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringInt(Slice []int, Map map[string]int) (map[int][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
 }
 
 // AnyValueInMapStringString checks if any of the values of a string slice is a value of map[string]string.
 // When either the slice or the map is empty, it returns false.
 func AnyValueInMapStringString(Slice []string, Map map[string]string) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapStringString(x, Map); ok {
-			return true
-		}
-	}
-	return false
+	return AnyValueInMap(Slice, Map)
 }
 
 // AllValuesInMapStringString checks if all values of a string slice are values of map[string]string.
 // When either the slice or the map is empty, it returns false.
 func AllValuesInMapStringString(Slice []string, Map map[string]string) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapStringString(x, Map); !ok {
-			return false
-		}
-	}
-	return true
+	return AllValuesInMap(Slice, Map)
 }
 
 // WhichValuesInMapStringString checks which values of a string slice are values of map[string]string.
@@ -411,35 +634,23 @@ func AllValuesInMapStringString(Slice []string, Map map[string]string) bool {
 // When either the slice or the map is empty, it returns false.
 // The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
 // and a boolean value (true if the returned map is not empty).
+// The keys in the returned map's slices are sorted in increasing order.
 func WhichValuesInMapStringString(Slice []string, Map map[string]string) (map[string][]string, bool) {
-	values := make(map[string][]string)
-	var exists bool
+	return WhichValuesInMapSorted(Slice, Map)
+}
 
-	if len(Slice) == 0 || len(Map) == 0 {
-		return map[string][]string{}, false
-	}
-	for _, x := range Slice {
-		if keys, ok := IsValueInMapStringString(x, Map); ok {
-			exists = true
-			values[x] = keys
-		}
-	}
-	return values, exists
+// WhichValuesNotInMapStringString checks which values of a string slice are not values of map[string]string.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringString(Slice []string, Map map[string]string) (map[string][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
 }
 
 // AnyValueInMapStringFloat64 checks if any of the values of a float64 slice is a value of map[string]float64.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // When either the slice or the map is empty, it returns false.
 func AnyValueInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilon float64) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapStringFloat64(x, Map, Epsilon); ok {
-			return true
-		}
-	}
-	return false
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
 }
 
 // AllValuesInMapStringFloat64 checks if all values of a float64 slice are values of map[string]float64.
@@ -447,15 +658,7 @@ func AnyValueInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilon
 // When either the slice or the map is empty, it returns false.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func AllValuesInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilon float64) bool {
-	if len(Slice) == 0 || len(Map) == 0 {
-		return false
-	}
-	for _, x := range Slice {
-		if _, ok := IsValueInMapStringFloat64(x, Map, Epsilon); !ok {
-			return false
-		}
-	}
-	return true
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
 }
 
 // WhichValuesInMapStringFloat64 checks which values of an int slice are values of map[int]float64.
@@ -466,18 +669,98 @@ func AllValuesInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilo
 // BEWARE! Do note that we work with floats, so it's safest to round them before using this function. Thus, this
 // WhichValuesInMapStringFloat64({[]float64{.01002, .01, .2}, map[string]float64{"a": .01, "b": .011}, .0001)
 // will return the following map: map[float64][]string{.01: {"a"}, .01002: {"a"}}}
+// The keys in the returned map's slices are sorted in increasing order.
 func WhichValuesInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilon float64) (map[float64][]string, bool) {
-	values := make(map[float64][]string)
-	var exists bool
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
 
-	if len(Slice) == 0 || len(Map) == 0 {
-		return map[float64][]string{}, false
-	}
-	for _, x := range Slice {
-		if keys, ok := IsValueInMapStringFloat64(x, Map, Epsilon); ok {
-			exists = true
-			values[x] = keys
-		}
-	}
-	return values, exists
+// WhichValuesNotInMapStringFloat64 checks which values of a float64 slice are not values of map[string]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilon float64) (map[float64][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInBoolSlice checks if all values of one bool slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInBoolSlice(Slice1, Slice2 []bool) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInBoolSlice checks if any of the values of one bool slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInBoolSlice(Slice1, Slice2 []bool) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInBoolSlice checks which values of one bool slice are in another slice.
+// When the first or the second (or both) slice is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInBoolSlice(Slice1, Slice2 []bool) (map[bool][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInBoolSlice checks which values of one bool slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInBoolSlice(Slice1, Slice2 []bool) (map[bool][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AnyValueInMapStringBool checks if any of the values of a bool slice is a value of map[string]bool.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringBool(Slice []bool, Map map[string]bool) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringBool checks if all values of a bool slice are values of map[string]bool.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringBool(Slice []bool, Map map[string]bool) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringBool checks which values of a bool slice are values of map[string]bool.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty).
+// The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringBool(Slice []bool, Map map[string]bool) (map[bool][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringBool checks which values of a bool slice are not values of map[string]bool.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringBool(Slice []bool, Map map[string]bool) (map[bool][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AnyValueInMapIntBool checks if any of the values of a bool slice is a value of map[int]bool.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntBool(Slice []bool, Map map[int]bool) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntBool checks if all values of a bool slice are values of map[int]bool.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntBool(Slice []bool, Map map[int]bool) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntBool checks which values of a bool slice are values of map[int]bool.
+// When either the slice or the map is empty, it returns false.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty).
+// The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntBool(Slice []bool, Map map[int]bool) (map[bool][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntBool checks which values of a bool slice are not values of map[int]bool.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntBool(Slice []bool, Map map[int]bool) (map[bool][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
 }
@@ -2,6 +2,7 @@ package check
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -1023,3 +1024,214 @@ func ExampleWhichValuesInMapStringFloat64() {
 	// true map[0.01:[a] 0.2:[b]]
 	// true map[0.01:[a] 0.01002:[a]]
 }
+
+func TestAllValuesIn(t *testing.T) {
+	type region string
+	tests := []struct {
+		slice1   []region
+		slice2   []region
+		expected bool
+	}{
+		{[]region{}, []region{"eu"}, true},
+		{[]region{"eu"}, []region{}, false},
+		{[]region{"eu", "us"}, []region{"us", "eu", "ap"}, true},
+		{[]region{"eu", "sa"}, []region{"us", "eu", "ap"}, false},
+	}
+	for _, test := range tests {
+		if AllValuesIn(test.slice1, test.slice2) != test.expected {
+			t.Errorf("AllValuesIn(%v, %v) should be %v", test.slice1, test.slice2, test.expected)
+		}
+	}
+}
+
+func TestAnyValueIn(t *testing.T) {
+	tests := []struct {
+		slice1   []uint64
+		slice2   []uint64
+		expected bool
+	}{
+		{[]uint64{}, []uint64{1}, false},
+		{[]uint64{1}, []uint64{}, false},
+		{[]uint64{1, 5}, []uint64{5, 6}, true},
+		{[]uint64{1, 2}, []uint64{5, 6}, false},
+	}
+	for _, test := range tests {
+		if AnyValueIn(test.slice1, test.slice2) != test.expected {
+			t.Errorf("AnyValueIn(%v, %v) should be %v", test.slice1, test.slice2, test.expected)
+		}
+	}
+}
+
+func TestWhichValuesIn(t *testing.T) {
+	tests := []struct {
+		slice1         []int64
+		slice2         []int64
+		expectedBool   bool
+		expectedValues map[int64][]int
+	}{
+		{[]int64{}, []int64{1}, false, map[int64][]int{}},
+		{[]int64{1, 2, 1}, []int64{}, false, map[int64][]int{}},
+		{[]int64{1, 2, 1}, []int64{2, 1, 2}, true, map[int64][]int{1: {1}, 2: {0, 2}}},
+		{[]int64{1, 2, 1}, []int64{3, 4}, false, map[int64][]int{}},
+	}
+	for _, test := range tests {
+		values, ok := WhichValuesIn(test.slice1, test.slice2)
+		if ok != test.expectedBool || len(values) != len(test.expectedValues) {
+			t.Errorf("WhichValuesIn(%v, %v) should be %v with values of %v, not %v with %v",
+				test.slice1, test.slice2, test.expectedBool, test.expectedValues, ok, values)
+			continue
+		}
+		for value, indices := range test.expectedValues {
+			if !AreEqualSlices(values[value], indices) {
+				t.Errorf("WhichValuesIn(%v, %v) should be %v with values of %v, not %v with %v",
+					test.slice1, test.slice2, test.expectedBool, test.expectedValues, ok, values)
+			}
+		}
+	}
+}
+
+func TestWhichValuesInFloat(t *testing.T) {
+	values, ok := WhichValuesInFloat([]float32{1, 1.05, 3}, []float32{1.05, 2, 1}, .1)
+	if !ok || len(values) != 1 || !AreEqualSlices(values[1], []int{0, 2}) {
+		t.Errorf("WhichValuesInFloat returned %v, %v; want map[1:[0 2]], true", values, ok)
+	}
+	values, ok = WhichValuesInFloat([]float32{1, 3}, []float32{2}, .1)
+	if ok || len(values) != 0 {
+		t.Errorf("WhichValuesInFloat returned %v, %v; want map[], false", values, ok)
+	}
+}
+
+func TestAllValuesInMap(t *testing.T) {
+	tests := []struct {
+		slice    []bool
+		Map      map[int64]bool
+		expected bool
+	}{
+		{[]bool{}, map[int64]bool{1: true}, false},
+		{[]bool{true}, map[int64]bool{}, false},
+		{[]bool{true, false}, map[int64]bool{1: true, 2: false}, true},
+		{[]bool{true, false}, map[int64]bool{1: true, 2: true}, false},
+	}
+	for _, test := range tests {
+		if AllValuesInMap(test.slice, test.Map) != test.expected {
+			t.Errorf("AllValuesInMap(%v, %v) should be %v", test.slice, test.Map, test.expected)
+		}
+		if AnyValueInMap(test.slice, test.Map) != (len(test.slice) > 0 && len(test.Map) > 0) {
+			t.Errorf("AnyValueInMap(%v, %v) should be %v", test.slice, test.Map, !test.expected)
+		}
+	}
+}
+
+func TestWhichValuesInMap(t *testing.T) {
+	values, ok := WhichValuesInMap([]uint8{1, 3}, map[string]uint8{"a": 1, "b": 2})
+	if !ok || len(values) != 1 || !AreEqualSlices(values[1], []string{"a"}) {
+		t.Errorf("WhichValuesInMap returned %v, %v; want map[1:[a]], true", values, ok)
+	}
+	values, ok = WhichValuesInMap([]uint8{}, map[string]uint8{"a": 1, "b": 2})
+	if ok || len(values) != 0 {
+		t.Errorf("WhichValuesInMap returned %v, %v; want map[], false", values, ok)
+	}
+}
+
+func TestWhichValuesInMapFloat(t *testing.T) {
+	values, ok := WhichValuesInMapFloat([]float32{1, 3}, map[int64]float32{10: 1.05, 20: 2}, .1)
+	if !ok || len(values) != 1 || !AreEqualSlices(values[1], []int64{10}) {
+		t.Errorf("WhichValuesInMapFloat returned %v, %v; want map[1:[10]], true", values, ok)
+	}
+	if !AnyValueInMapFloat([]float32{1, 3}, map[int64]float32{10: 1.05, 20: 2}, .1) {
+		t.Errorf("AnyValueInMapFloat should be true")
+	}
+	if AllValuesInMapFloat([]float32{1, 3}, map[int64]float32{10: 1.05, 20: 2}, .1) {
+		t.Errorf("AllValuesInMapFloat should be false")
+	}
+}
+
+func ExampleWhichValuesIn() {
+	values, ok := WhichValuesIn([]uint{10, 20}, []uint{10, 10, 50})
+	fmt.Println(ok, values)
+	// Output:
+	// true map[10:[0 1]]
+}
+
+func TestWhichValuesNotInIntSlice(t *testing.T) {
+	tests := []struct {
+		slice1   []int
+		slice2   []int
+		expected map[int][]int
+	}{
+		{[]int{}, []int{1}, map[int][]int{}},
+		{[]int{1, 2}, []int{}, map[int][]int{1: {0}, 2: {1}}},
+		{[]int{1, 2, 3, 2}, []int{1, 3}, map[int][]int{2: {1, 3}}},
+		{[]int{1, 3}, []int{1, 2, 3}, map[int][]int{}},
+	}
+	for _, test := range tests {
+		actual, ok := WhichValuesNotInIntSlice(test.slice1, test.slice2)
+		if !areEqualIndexMaps(actual, test.expected) || ok != (len(test.expected) > 0) {
+			t.Errorf("WhichValuesNotInIntSlice(%v, %v) = %v, %v; want %v", test.slice1, test.slice2, actual, ok, test.expected)
+		}
+	}
+}
+
+func areEqualIndexMaps[K comparable](Map1, Map2 map[K][]int) bool {
+	if len(Map1) != len(Map2) {
+		return false
+	}
+	for key, indices := range Map1 {
+		if !AreEqualSlicesInt(indices, Map2[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+func ExampleWhichValuesNotInStringSlice() {
+	fmt.Println(WhichValuesNotInStringSlice([]string{"a", "b", "c", "b"}, []string{"a", "c"}))
+	// Output:
+	// map[b:[1 3]] true
+}
+
+func TestWhichValuesNotInFloat64Slice(t *testing.T) {
+	actual, ok := WhichValuesNotInFloat64Slice([]float64{.1, .2, .3, .2}, []float64{.101, .3}, .01)
+	if !areEqualIndexMaps(actual, map[float64][]int{.2: {1, 3}}) || !ok {
+		t.Errorf("WhichValuesNotInFloat64Slice([.1 .2 .3 .2], [.101 .3], .01) = %v, %v; want map[0.2:[1 3]], true", actual, ok)
+	}
+	nans, ok := WhichValuesNotInTol([]float64{math.NaN(), 1, math.NaN()}, []float64{1, math.NaN()}, AbsTolerance(0))
+	if len(nans) != 1 || !ok {
+		t.Errorf("WhichValuesNotInTol([NaN 1 NaN], [1 NaN]) = %v, %v; want map[NaN:[0 2]], true", nans, ok)
+	}
+	for key, indices := range nans {
+		if !math.IsNaN(key) || !AreEqualSlicesInt(indices, []int{0, 2}) {
+			t.Errorf("WhichValuesNotInTol([NaN 1 NaN], [1 NaN]) = %v; want map[NaN:[0 2]]", nans)
+		}
+	}
+	if nans, ok := WhichValuesNotInTol([]float64{math.NaN()}, []float64{math.NaN()}, AbsTolerance(0).WithPolicy(MissingDataPolicy)); len(nans) != 0 || ok {
+		t.Errorf("WhichValuesNotInTol([NaN], [NaN]) with MissingDataPolicy = %v, %v; want map[], false", nans, ok)
+	}
+}
+
+func TestWhichValuesNotInMaps(t *testing.T) {
+	actual, ok := WhichValuesNotInMapIntInt([]int{1, 5, 2, 5}, map[int]int{10: 1, 20: 2})
+	if !areEqualIndexMaps(actual, map[int][]int{5: {1, 3}}) || !ok {
+		t.Errorf("WhichValuesNotInMapIntInt() = %v, %v; want map[5:[1 3]], true", actual, ok)
+	}
+	actualStrings, ok := WhichValuesNotInMapIntString([]string{"a"}, map[int]string{})
+	if !areEqualIndexMaps(actualStrings, map[string][]int{"a": {0}}) || !ok {
+		t.Errorf("WhichValuesNotInMapIntString([a], map[]) = %v, %v; want map[a:[0]], true", actualStrings, ok)
+	}
+	actualFloats, ok := WhichValuesNotInMapIntFloat64([]float64{.1, .2}, map[int]float64{1: .105}, .01)
+	if !areEqualIndexMaps(actualFloats, map[float64][]int{.2: {1}}) || !ok {
+		t.Errorf("WhichValuesNotInMapIntFloat64() = %v, %v; want map[0.2:[1]], true", actualFloats, ok)
+	}
+	actual, ok = WhichValuesNotInMapStringInt([]int{1}, map[string]int{"a": 1})
+	if len(actual) != 0 || ok {
+		t.Errorf("WhichValuesNotInMapStringInt([1], map[a:1]) = %v, %v; want map[], false", actual, ok)
+	}
+	actualStrings, ok = WhichValuesNotInMapStringString([]string{"x", "y"}, map[string]string{"a": "x"})
+	if !areEqualIndexMaps(actualStrings, map[string][]int{"y": {1}}) || !ok {
+		t.Errorf("WhichValuesNotInMapStringString() = %v, %v; want map[y:[1]], true", actualStrings, ok)
+	}
+	actualFloats, ok = WhichValuesNotInMapStringFloat64([]float64{.1}, map[string]float64{"a": .2}, 0)
+	if !areEqualIndexMaps(actualFloats, map[float64][]int{.1: {0}}) || !ok {
+		t.Errorf("WhichValuesNotInMapStringFloat64() = %v, %v; want map[0.1:[0]], true", actualFloats, ok)
+	}
+}
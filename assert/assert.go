@@ -0,0 +1,313 @@
+/*
+Package assert offers test assertions built on top of the check package.
+
+Each assertion takes a testing.TB, calls t.Helper() and, when the check fails, reports a readable description
+of what differs. Assertions come in two variants:
+
+* non-fatal ones (like EqualSlicesInt), which report the failure using t.Errorf and return whether the check passed
+* fatal ones (like RequireEqualSlicesInt), which report the failure using t.Fatalf, thus stopping the test
+
+For instance, instead of
+
+	if !check.AreEqualSlicesInt(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+you can write
+
+	assert.EqualSlicesInt(t, got, want)
+*/
+package assert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/nyggus/check"
+)
+
+// failFunc reports a failed assertion; it is either t.Errorf or t.Fatalf.
+type failFunc func(format string, args ...any)
+
+// EqualSlices asserts that two slices of any comparable type have the same values in the same order.
+// It reports the differences using t.Errorf and returns true if the slices are the same.
+func EqualSlices[T comparable](t testing.TB, got, want []T) bool {
+	t.Helper()
+	return equalSlices(t, t.Errorf, got, want)
+}
+
+// RequireEqualSlices works like EqualSlices, but reports the differences using t.Fatalf.
+func RequireEqualSlices[T comparable](t testing.TB, got, want []T) {
+	t.Helper()
+	equalSlices(t, t.Fatalf, got, want)
+}
+
+func equalSlices[T comparable](t testing.TB, fail failFunc, got, want []T) bool {
+	t.Helper()
+	if check.AreEqualSlices(got, want) {
+		return true
+	}
+	fail("slices differ:\n%v", check.DiffSlices(got, want))
+	return false
+}
+
+// EqualSlicesFloat asserts that two slices of any float type have the same values in the same order.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// It reports the differences using t.Errorf and returns true if the slices are the same.
+func EqualSlicesFloat[T check.Float](t testing.TB, got, want []T, Epsilon float64) bool {
+	t.Helper()
+	return equalSlicesFloat(t, t.Errorf, got, want, Epsilon)
+}
+
+// RequireEqualSlicesFloat works like EqualSlicesFloat, but reports the differences using t.Fatalf.
+func RequireEqualSlicesFloat[T check.Float](t testing.TB, got, want []T, Epsilon float64) {
+	t.Helper()
+	equalSlicesFloat(t, t.Fatalf, got, want, Epsilon)
+}
+
+func equalSlicesFloat[T check.Float](t testing.TB, fail failFunc, got, want []T, Epsilon float64) bool {
+	t.Helper()
+	if check.AreEqualSlicesFloat(got, want, Epsilon) {
+		return true
+	}
+	fail("slices differ:\n%v", check.DiffSlicesFloat(got, want, Epsilon))
+	return false
+}
+
+// EqualSlicesInt asserts that two int slices are the same; see EqualSlices.
+func EqualSlicesInt(t testing.TB, got, want []int) bool {
+	t.Helper()
+	return equalSlices(t, t.Errorf, got, want)
+}
+
+// RequireEqualSlicesInt asserts that two int slices are the same; see RequireEqualSlices.
+func RequireEqualSlicesInt(t testing.TB, got, want []int) {
+	t.Helper()
+	equalSlices(t, t.Fatalf, got, want)
+}
+
+// EqualSlicesString asserts that two string slices are the same; see EqualSlices.
+func EqualSlicesString(t testing.TB, got, want []string) bool {
+	t.Helper()
+	return equalSlices(t, t.Errorf, got, want)
+}
+
+// RequireEqualSlicesString asserts that two string slices are the same; see RequireEqualSlices.
+func RequireEqualSlicesString(t testing.TB, got, want []string) {
+	t.Helper()
+	equalSlices(t, t.Fatalf, got, want)
+}
+
+// EqualSlicesFloat64 asserts that two float64 slices are the same; see EqualSlicesFloat.
+func EqualSlicesFloat64(t testing.TB, got, want []float64, Epsilon float64) bool {
+	t.Helper()
+	return equalSlicesFloat(t, t.Errorf, got, want, Epsilon)
+}
+
+// RequireEqualSlicesFloat64 asserts that two float64 slices are the same; see RequireEqualSlicesFloat.
+func RequireEqualSlicesFloat64(t testing.TB, got, want []float64, Epsilon float64) {
+	t.Helper()
+	equalSlicesFloat(t, t.Fatalf, got, want, Epsilon)
+}
+
+// EqualMaps asserts that two maps contain the same key-value pairs.
+// It reports the differences using t.Errorf and returns true if the maps are the same.
+func EqualMaps[K check.Ordered, V comparable](t testing.TB, got, want map[K]V) bool {
+	t.Helper()
+	return equalMaps(t, t.Errorf, got, want)
+}
+
+// RequireEqualMaps works like EqualMaps, but reports the differences using t.Fatalf.
+func RequireEqualMaps[K check.Ordered, V comparable](t testing.TB, got, want map[K]V) {
+	t.Helper()
+	equalMaps(t, t.Fatalf, got, want)
+}
+
+func equalMaps[K check.Ordered, V comparable](t testing.TB, fail failFunc, got, want map[K]V) bool {
+	t.Helper()
+	if d := check.DiffMaps(got, want); !d.IsEmpty() {
+		fail("maps differ:\n%v", d)
+		return false
+	}
+	return true
+}
+
+// EqualMapsFloat asserts that two maps with float values contain the same key-value pairs.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// It reports the differences using t.Errorf and returns true if the maps are the same.
+func EqualMapsFloat[K check.Ordered, V check.Float](t testing.TB, got, want map[K]V, Epsilon float64) bool {
+	t.Helper()
+	return equalMapsFloat(t, t.Errorf, got, want, Epsilon)
+}
+
+// RequireEqualMapsFloat works like EqualMapsFloat, but reports the differences using t.Fatalf.
+func RequireEqualMapsFloat[K check.Ordered, V check.Float](t testing.TB, got, want map[K]V, Epsilon float64) {
+	t.Helper()
+	equalMapsFloat(t, t.Fatalf, got, want, Epsilon)
+}
+
+func equalMapsFloat[K check.Ordered, V check.Float](t testing.TB, fail failFunc, got, want map[K]V, Epsilon float64) bool {
+	t.Helper()
+	if d := check.DiffMapsFloat(got, want, Epsilon); !d.IsEmpty() {
+		fail("maps differ:\n%v", d)
+		return false
+	}
+	return true
+}
+
+// ValueIn asserts that a value (X) is in a slice.
+// It reports the failure using t.Errorf and returns true if the value is in the slice.
+func ValueIn[T comparable](t testing.TB, X T, Slice []T) bool {
+	t.Helper()
+	return valueIn(t, t.Errorf, X, Slice)
+}
+
+// RequireValueIn works like ValueIn, but reports the failure using t.Fatalf.
+func RequireValueIn[T comparable](t testing.TB, X T, Slice []T) {
+	t.Helper()
+	valueIn(t, t.Fatalf, X, Slice)
+}
+
+func valueIn[T comparable](t testing.TB, fail failFunc, X T, Slice []T) bool {
+	t.Helper()
+	if check.IsValueIn(X, Slice) {
+		return true
+	}
+	fail("%v is not in %v", X, Slice)
+	return false
+}
+
+// AllValuesIn asserts that all values of one slice are in another slice, using check.AllValuesIn.
+// It reports the missing values using t.Errorf and returns true if all values are in the slice.
+func AllValuesIn[T comparable](t testing.TB, Values, Slice []T) bool {
+	t.Helper()
+	return allValuesIn(t, t.Errorf, Values, Slice)
+}
+
+// RequireAllValuesIn works like AllValuesIn, but reports the missing values using t.Fatalf.
+func RequireAllValuesIn[T comparable](t testing.TB, Values, Slice []T) {
+	t.Helper()
+	allValuesIn(t, t.Fatalf, Values, Slice)
+}
+
+func allValuesIn[T comparable](t testing.TB, fail failFunc, Values, Slice []T) bool {
+	t.Helper()
+	if check.AllValuesIn(Values, Slice) {
+		return true
+	}
+	missing := make([]T, 0)
+	for _, value := range check.UniqueSlice(Values) {
+		if !check.IsValueIn(value, Slice) {
+			missing = append(missing, value)
+		}
+	}
+	fail("values %v are not in %v", missing, Slice)
+	return false
+}
+
+// AllValuesInFloat asserts that all values of one float slice are in another slice, using check.AllValuesInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// It reports the missing values using t.Errorf and returns true if all values are in the slice.
+func AllValuesInFloat[T check.Float](t testing.TB, Values, Slice []T, Epsilon float64) bool {
+	t.Helper()
+	return allValuesInFloat(t, t.Errorf, Values, Slice, Epsilon)
+}
+
+// RequireAllValuesInFloat works like AllValuesInFloat, but reports the missing values using t.Fatalf.
+func RequireAllValuesInFloat[T check.Float](t testing.TB, Values, Slice []T, Epsilon float64) {
+	t.Helper()
+	allValuesInFloat(t, t.Fatalf, Values, Slice, Epsilon)
+}
+
+func allValuesInFloat[T check.Float](t testing.TB, fail failFunc, Values, Slice []T, Epsilon float64) bool {
+	t.Helper()
+	if check.AllValuesInFloat(Values, Slice, Epsilon) {
+		return true
+	}
+	missing := make([]T, 0)
+	for _, value := range check.UniqueSliceFloat(Values, Epsilon) {
+		if !check.IsValueInFloat(value, Slice, Epsilon) {
+			missing = append(missing, value)
+		}
+	}
+	fail("values %v are not in %v (epsilon = %v)", missing, Slice, Epsilon)
+	return false
+}
+
+// KeyValuePairsIn asserts that all key-value pairs of one map are in another map, using check.AllKeyValuePairsInMap.
+// It reports the absent and differing pairs using t.Errorf and returns true if all pairs are in the map.
+// Like check.AllKeyValuePairsInMap, it fails when any of the maps is empty.
+func KeyValuePairsIn[K check.Ordered, V comparable](t testing.TB, Pairs, Map map[K]V) bool {
+	t.Helper()
+	return keyValuePairsIn(t, t.Errorf, Pairs, Map)
+}
+
+// RequireKeyValuePairsIn works like KeyValuePairsIn, but reports the absent and differing pairs using t.Fatalf.
+func RequireKeyValuePairsIn[K check.Ordered, V comparable](t testing.TB, Pairs, Map map[K]V) {
+	t.Helper()
+	keyValuePairsIn(t, t.Fatalf, Pairs, Map)
+}
+
+func keyValuePairsIn[K check.Ordered, V comparable](t testing.TB, fail failFunc, Pairs, Map map[K]V) bool {
+	t.Helper()
+	if check.AllKeyValuePairsInMap(Pairs, Map) {
+		return true
+	}
+	if len(Pairs) == 0 || len(Map) == 0 {
+		fail("key-value pairs %v are not in %v: empty map", Pairs, Map)
+		return false
+	}
+	fail("key-value pairs are not in %v:\n%v", Map, describePairs(Pairs, Map, func(X, Y V) bool { return X == Y }))
+	return false
+}
+
+// KeyValuePairsInFloat asserts that all key-value pairs of one map are in another map, using check.AllKeyValuePairsInMapFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// It reports the absent and differing pairs using t.Errorf and returns true if all pairs are in the map.
+func KeyValuePairsInFloat[K check.Ordered, V check.Float](t testing.TB, Pairs, Map map[K]V, Epsilon float64) bool {
+	t.Helper()
+	return keyValuePairsInFloat(t, t.Errorf, Pairs, Map, Epsilon)
+}
+
+// RequireKeyValuePairsInFloat works like KeyValuePairsInFloat, but reports the absent and differing pairs using t.Fatalf.
+func RequireKeyValuePairsInFloat[K check.Ordered, V check.Float](t testing.TB, Pairs, Map map[K]V, Epsilon float64) {
+	t.Helper()
+	keyValuePairsInFloat(t, t.Fatalf, Pairs, Map, Epsilon)
+}
+
+func keyValuePairsInFloat[K check.Ordered, V check.Float](t testing.TB, fail failFunc, Pairs, Map map[K]V, Epsilon float64) bool {
+	t.Helper()
+	if check.AllKeyValuePairsInMapFloat(Pairs, Map, Epsilon) {
+		return true
+	}
+	if len(Pairs) == 0 || len(Map) == 0 {
+		fail("key-value pairs %v are not in %v: empty map", Pairs, Map)
+		return false
+	}
+	equal := func(X, Y V) bool { return check.IsValueInFloat(X, []V{Y}, Epsilon) }
+	fail("key-value pairs are not in %v (epsilon = %v):\n%v", Map, Epsilon, describePairs(Pairs, Map, equal))
+	return false
+}
+
+// describePairs lists, sorted by key, the pairs that are not in the map, one per line.
+func describePairs[K check.Ordered, V any](Pairs, Map map[K]V, equal func(X, Y V) bool) string {
+	keys := make([]K, 0, len(Pairs))
+	for key := range Pairs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	lines := make([]string, 0)
+	for _, key := range keys {
+		value, ok := Map[key]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("key %v: want %v, key absent", key, Pairs[key]))
+			continue
+		}
+		if !equal(Pairs[key], value) {
+			lines = append(lines, fmt.Sprintf("key %v: want %v, got %v", key, Pairs[key], value))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
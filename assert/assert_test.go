@@ -0,0 +1,123 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// recorder is a testing.TB that records reported failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+	fatals []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.fatals = append(r.fatals, fmt.Sprintf(format, args...))
+}
+
+func TestEqualSlicesInt(t *testing.T) {
+	r := &recorder{TB: t}
+	if !EqualSlicesInt(r, []int{1, 2}, []int{1, 2}) || len(r.errors) != 0 {
+		t.Errorf("EqualSlicesInt should pass for the same slices, but reported %v", r.errors)
+	}
+	if EqualSlicesInt(r, []int{1, 2}, []int{1, 3}) {
+		t.Errorf("EqualSlicesInt should fail for different slices")
+	}
+	if len(r.errors) != 1 || r.errors[0] != "slices differ:\nchanged index 1: 2 != 3" {
+		t.Errorf("EqualSlicesInt reported %q", r.errors)
+	}
+	if len(r.fatals) != 0 {
+		t.Errorf("EqualSlicesInt should not call Fatalf")
+	}
+}
+
+func TestRequireEqualSlicesInt(t *testing.T) {
+	r := &recorder{TB: t}
+	RequireEqualSlicesInt(r, []int{1, 2}, []int{1})
+	if len(r.fatals) != 1 || r.fatals[0] != "slices differ:\nextra index 1: 2" {
+		t.Errorf("RequireEqualSlicesInt reported %q", r.fatals)
+	}
+	if len(r.errors) != 0 {
+		t.Errorf("RequireEqualSlicesInt should not call Errorf")
+	}
+}
+
+func TestEqualSlicesFloat64(t *testing.T) {
+	r := &recorder{TB: t}
+	if !EqualSlicesFloat64(r, []float64{1, 2}, []float64{1.05, 2}, .1) {
+		t.Errorf("EqualSlicesFloat64 should pass for values within Epsilon")
+	}
+	if EqualSlicesFloat64(r, []float64{1, 2}, []float64{1.5, 2}, .1) {
+		t.Errorf("EqualSlicesFloat64 should fail for values further than Epsilon")
+	}
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "epsilon = 0.1") {
+		t.Errorf("EqualSlicesFloat64 reported %q", r.errors)
+	}
+}
+
+func TestEqualMaps(t *testing.T) {
+	r := &recorder{TB: t}
+	if !EqualMaps(r, map[string]int{"a": 1}, map[string]int{"a": 1}) {
+		t.Errorf("EqualMaps should pass for the same maps")
+	}
+	if EqualMaps(r, map[string]int{"a": 0}, map[string]int{"b": 0}) {
+		t.Errorf("EqualMaps should fail for maps with different keys")
+	}
+	RequireEqualMapsFloat(r, map[int]float64{1: 1}, map[int]float64{1: 2}, .5)
+	if len(r.errors) != 1 || len(r.fatals) != 1 {
+		t.Errorf("EqualMaps reported %q and %q", r.errors, r.fatals)
+	}
+}
+
+func TestAllValuesIn(t *testing.T) {
+	r := &recorder{TB: t}
+	if !AllValuesIn(r, []string{"eu"}, []string{"eu", "us"}) {
+		t.Errorf("AllValuesIn should pass when all values are in the slice")
+	}
+	if AllValuesIn(r, []string{"eu", "ap", "sa", "ap"}, []string{"eu", "us"}) {
+		t.Errorf("AllValuesIn should fail when some values are not in the slice")
+	}
+	if len(r.errors) != 1 || r.errors[0] != "values [ap sa] are not in [eu us]" {
+		t.Errorf("AllValuesIn reported %q", r.errors)
+	}
+	RequireAllValuesInFloat(r, []float64{1, 2}, []float64{1.01}, .1)
+	if len(r.fatals) != 1 || r.fatals[0] != "values [2] are not in [1.01] (epsilon = 0.1)" {
+		t.Errorf("RequireAllValuesInFloat reported %q", r.fatals)
+	}
+}
+
+func TestKeyValuePairsIn(t *testing.T) {
+	r := &recorder{TB: t}
+	config := map[string]string{"region": "eu", "tier": "free"}
+	if !KeyValuePairsIn(r, map[string]string{"region": "eu"}, config) {
+		t.Errorf("KeyValuePairsIn should pass when all pairs are in the map")
+	}
+	if KeyValuePairsIn(r, map[string]string{"region": "us", "zone": "a", "tier": "free"}, config) {
+		t.Errorf("KeyValuePairsIn should fail when some pairs are not in the map")
+	}
+	if len(r.errors) != 1 || !strings.HasSuffix(r.errors[0], "\nkey region: want us, got eu\nkey zone: want a, key absent") {
+		t.Errorf("KeyValuePairsIn reported %q", r.errors)
+	}
+	RequireKeyValuePairsInFloat(r, map[int]float64{1: 1}, map[int]float64{1: 1.2}, .1)
+	if len(r.fatals) != 1 || !strings.HasSuffix(r.fatals[0], "\nkey 1: want 1, got 1.2") {
+		t.Errorf("RequireKeyValuePairsInFloat reported %q", r.fatals)
+	}
+}
+
+func TestValueIn(t *testing.T) {
+	r := &recorder{TB: t}
+	if !ValueIn(r, 2, []int{1, 2}) || ValueIn(r, 3, []int{1, 2}) {
+		t.Errorf("ValueIn returned wrong results")
+	}
+	if len(r.errors) != 1 || r.errors[0] != "3 is not in [1 2]" {
+		t.Errorf("ValueIn reported %q", r.errors)
+	}
+}
@@ -0,0 +1,118 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBoolSlices(t *testing.T) {
+	tests := []struct {
+		Slice1      []bool
+		Slice2      []bool
+		equal       bool
+		equalSorted bool
+		all         bool
+		any         bool
+	}{
+		{[]bool{}, []bool{}, true, true, true, false},
+		{[]bool{true}, []bool{}, false, false, false, false},
+		{[]bool{true, false}, []bool{true, false}, true, true, true, true},
+		{[]bool{true, false}, []bool{false, true}, false, true, true, true},
+		{[]bool{true, true}, []bool{true, false}, false, false, true, true},
+		{[]bool{false, false}, []bool{true, true}, false, false, false, false},
+	}
+	for _, test := range tests {
+		if got := AreEqualSlicesBool(test.Slice1, test.Slice2); got != test.equal {
+			t.Errorf("AreEqualSlicesBool(%v, %v) = %v; want %v", test.Slice1, test.Slice2, got, test.equal)
+		}
+		if got := AreEqualSortedSlicesBool(test.Slice1, test.Slice2); got != test.equalSorted {
+			t.Errorf("AreEqualSortedSlicesBool(%v, %v) = %v; want %v", test.Slice1, test.Slice2, got, test.equalSorted)
+		}
+		if got := AllValuesInBoolSlice(test.Slice1, test.Slice2); got != test.all {
+			t.Errorf("AllValuesInBoolSlice(%v, %v) = %v; want %v", test.Slice1, test.Slice2, got, test.all)
+		}
+		if got := AnyValueInBoolSlice(test.Slice1, test.Slice2); got != test.any {
+			t.Errorf("AnyValueInBoolSlice(%v, %v) = %v; want %v", test.Slice1, test.Slice2, got, test.any)
+		}
+	}
+	if !IsValueInBoolSlice(false, []bool{true, false}) || IsValueInBoolSlice(false, []bool{true, true}) {
+		t.Errorf("IsValueInBoolSlice should check if the value is in the slice")
+	}
+	which, ok := WhichValuesInBoolSlice([]bool{true, false}, []bool{true, true})
+	if !ok || len(which) != 1 || !AreEqualSlicesInt(which[true], []int{0, 1}) {
+		t.Errorf("WhichValuesInBoolSlice([true false], [true true]) = %v, %v; want map[true:[0 1]] true", which, ok)
+	}
+	missing, ok := WhichValuesNotInBoolSlice([]bool{false, true, false}, []bool{true})
+	if !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[false], []int{0, 2}) {
+		t.Errorf("WhichValuesNotInBoolSlice([false true false], [true]) = %v, %v; want map[false:[0 2]] true", missing, ok)
+	}
+	if diff := DiffSlicesBool([]bool{true, false}, []bool{true, true}); len(diff.Changed) != 1 || diff.Changed[0].Key != 1 {
+		t.Errorf("DiffSlicesBool([true false], [true true]).Changed = %v; want a change at index 1", diff.Changed)
+	}
+}
+
+func TestBoolMaps(t *testing.T) {
+	flags := map[string]bool{"beta": true, "dark": false, "new": true}
+	tests := []struct {
+		other          map[string]bool
+		equal          bool
+		missingAsFalse bool
+		allPairs       bool
+		anyPair        bool
+	}{
+		{map[string]bool{"beta": true, "dark": false, "new": true}, true, true, true, true},
+		{map[string]bool{"beta": true, "new": true}, false, true, false, true},
+		{map[string]bool{"beta": true, "dark": false, "new": true, "old": false}, false, true, true, true},
+		{map[string]bool{"beta": true, "dark": true, "new": true}, false, false, false, true},
+		{map[string]bool{"beta": false, "dark": true, "new": false}, false, false, false, false},
+	}
+	for _, test := range tests {
+		if got := AreEqualMapsStringBool(flags, test.other); got != test.equal {
+			t.Errorf("AreEqualMapsStringBool(%v, %v) = %v; want %v", flags, test.other, got, test.equal)
+		}
+		if got := AreEqualMapsStringBoolMissingAsZero(flags, test.other); got != test.missingAsFalse {
+			t.Errorf("AreEqualMapsStringBoolMissingAsZero(%v, %v) = %v; want %v", flags, test.other, got, test.missingAsFalse)
+		}
+		if got := AllKeyValuePairsInMapStringBool(flags, test.other); got != test.allPairs {
+			t.Errorf("AllKeyValuePairsInMapStringBool(%v, %v) = %v; want %v", flags, test.other, got, test.allPairs)
+		}
+		if got := AnyKeyValuePairInMapStringBool(flags, test.other); got != test.anyPair {
+			t.Errorf("AnyKeyValuePairInMapStringBool(%v, %v) = %v; want %v", flags, test.other, got, test.anyPair)
+		}
+	}
+	keys, ok := IsValueInMapStringBool(true, flags)
+	if !ok || !AreEqualSlicesString(keys, []string{"beta", "new"}) {
+		t.Errorf("IsValueInMapStringBool(true, %v) = %v, %v; want [beta new] true", flags, keys, ok)
+	}
+	which, ok := WhichValuesInMapIntBool([]bool{false, true}, map[int]bool{3: false, 1: false})
+	if !ok || len(which) != 1 || !AreEqualSlicesInt(which[false], []int{1, 3}) {
+		t.Errorf("WhichValuesInMapIntBool = %v, %v; want map[false:[1 3]] true", which, ok)
+	}
+	if !AllValuesInMapIntBool([]bool{true}, map[int]bool{1: true}) || AnyValueInMapIntBool([]bool{false}, map[int]bool{1: true}) {
+		t.Errorf("AllValuesInMapIntBool and AnyValueInMapIntBool should check the map's values")
+	}
+	if missing, ok := WhichValuesNotInMapStringBool([]bool{true, false}, map[string]bool{"a": true}); !ok || !AreEqualSlicesInt(missing[false], []int{1}) {
+		t.Errorf("WhichValuesNotInMapStringBool = %v, %v; want map[false:[1]] true", missing, ok)
+	}
+	mismatches, ok := WhichKeyValuePairsNotInMapIntBool(map[int]bool{1: true, 2: false}, map[int]bool{1: false})
+	if !ok || len(mismatches) != 2 || !mismatches[2].KeyAbsent || mismatches[1].OtherValue {
+		t.Errorf("WhichKeyValuePairsNotInMapIntBool = %v, %v", mismatches, ok)
+	}
+	if pairs, ok := WhichKeyValuePairsInMapIntBool(map[int]bool{1: true, 2: false}, map[int]bool{2: false}); !ok || !AreEqualMapsIntBool(pairs, map[int]bool{2: false}) {
+		t.Errorf("WhichKeyValuePairsInMapIntBool = %v, %v; want map[2:false] true", pairs, ok)
+	}
+	if diff := DiffMapsStringBool(flags, map[string]bool{"beta": false, "dark": false}); len(diff.Changed) != 1 || diff.Changed[0].Key != "beta" || len(diff.Extra) != 1 {
+		t.Errorf("DiffMapsStringBool(%v, ...) = %v; want beta changed and new extra", flags, diff)
+	}
+}
+
+func ExampleAreEqualMapsStringBool() {
+	flags := map[string]bool{"beta": true, "dark": false}
+	fmt.Println(AreEqualMapsStringBool(flags, map[string]bool{"beta": true}))
+	fmt.Println(AreEqualMapsStringBoolMissingAsZero(flags, map[string]bool{"beta": true}))
+	fmt.Println(WhichKeyValuePairsNotInMapStringBool(flags, map[string]bool{"beta": false, "dark": false}))
+	// Output:
+	// false
+	// true
+	// map[beta:{true false false}] true
+}
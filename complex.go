@@ -0,0 +1,536 @@
+package check
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// ComplexTolerance sets how close two complex numbers need to be to be considered equal.
+// By default, the Tolerance bounds the modulus of the difference between X and Y:
+//
+//	|X - Y| <= Abs + Rel*|Y|
+//
+// In this modulus mode, only the Tolerance's Abs and Rel are used; ULPs and Policy.SignedZeros are ignored,
+// since they have no meaning for the modulus.
+// With PerPart, the Tolerance is applied separately to the real and to the imaginary parts,
+// which are compared as two floats (so that ULPs and SignedZeros are taken into account as well).
+// Complex numbers with a NaN or an infinite part are always compared part by part, following the Tolerance's Policy
+// (in modulus mode too, so ULPs and SignedZeros can then apply to the other part).
+//
+// Use ModulusTolerance and PerPartTolerance to create a ComplexTolerance.
+// Functions accepting a ComplexTolerance have the ComplexTol suffix, like IsValueInComplexTol and AreEqualSlicesComplexTol.
+type ComplexTolerance struct {
+	Tolerance Tolerance
+	PerPart   bool
+}
+
+// ModulusTolerance returns a ComplexTolerance under which two complex numbers are equal when the modulus of their difference
+// is less than or equal to Epsilon. This is what the Epsilon parameter means in all functions working with complex numbers.
+func ModulusTolerance(Epsilon float64) ComplexTolerance {
+	return ComplexTolerance{Tolerance: AbsTolerance(Epsilon)}
+}
+
+// PerPartTolerance returns a ComplexTolerance under which two complex numbers are equal when both
+// their real parts and their imaginary parts are within the tolerance.
+func PerPartTolerance(Tol Tolerance) ComplexTolerance {
+	return ComplexTolerance{Tolerance: Tol, PerPart: true}
+}
+
+// Equal checks if two complex128 values are equal within the tolerance.
+func (Tol ComplexTolerance) Equal(X, Y complex128) bool {
+	return isWithinComplexTolerance(X, Y, Tol)
+}
+
+// isWithinComplexTolerance checks if two complex numbers are equal within the tolerance.
+// In modulus mode, it uses only Abs and Rel (see ComplexTolerance).
+func isWithinComplexTolerance[T Complex](X, Y T, Tol ComplexTolerance) bool {
+	x, y := complex128(X), complex128(Y)
+	if Tol.PerPart || isSpecialComplex(x) || isSpecialComplex(y) {
+		return isWithinTolerance(real(x), real(y), Tol.Tolerance) && isWithinTolerance(imag(x), imag(y), Tol.Tolerance)
+	}
+	return cmplx.Abs(x-y) <= Tol.Tolerance.Abs+Tol.Tolerance.Rel*cmplx.Abs(y)
+}
+
+// isSpecialComplex checks if either part of a complex number is NaN or infinite.
+func isSpecialComplex(X complex128) bool {
+	return math.IsNaN(real(X)) || math.IsNaN(imag(X)) || math.IsInf(real(X), 0) || math.IsInf(imag(X), 0)
+}
+
+// IsValueInComplexTol checks if a complex number (X) is in a slice. It works with slices of any complex type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+func IsValueInComplexTol[T Complex](X T, Slice []T, Tol ComplexTolerance) bool {
+	for _, value := range Slice {
+		if isWithinComplexTolerance(X, value, Tol) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValueInComplex checks if a complex number (X) is in a slice. It works with slices of any complex type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func IsValueInComplex[T Complex](X T, Slice []T, Epsilon float64) bool {
+	return IsValueInComplexTol(X, Slice, ModulusTolerance(Epsilon))
+}
+
+// AllValuesInComplexTol checks if all values of one complex slice are in another slice. It works with slices of any complex type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInComplexTol[T Complex](Slice1, Slice2 []T, Tol ComplexTolerance) bool {
+	if len(Slice1) == 0 {
+		return true
+	}
+	if len(Slice2) == 0 {
+		return false
+	}
+	for _, x := range Slice1 {
+		if !IsValueInComplexTol(x, Slice2, Tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllValuesInComplex checks if all values of one complex slice are in another slice. It works with slices of any complex type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInComplex[T Complex](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AllValuesInComplexTol(Slice1, Slice2, ModulusTolerance(Epsilon))
+}
+
+// AnyValueInComplexTol checks if any of the values of one complex slice is in another slice. It works with slices of any complex type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInComplexTol[T Complex](Slice1, Slice2 []T, Tol ComplexTolerance) bool {
+	for _, x := range Slice1 {
+		if IsValueInComplexTol(x, Slice2, Tol) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyValueInComplex checks if any of the values of one complex slice is in another slice. It works with slices of any complex type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInComplex[T Complex](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AnyValueInComplexTol(Slice1, Slice2, ModulusTolerance(Epsilon))
+}
+
+// WhichValuesInComplexTol checks which values of one complex slice are in another slice. It works with slices of any complex type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// When the first or the second (or both) slice is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+// Like WhichValuesInTol, it first removes the values of Slice1 that are duplicates of earlier values.
+func WhichValuesInComplexTol[T Complex](Slice1, Slice2 []T, Tol ComplexTolerance) (map[T][]int, bool) {
+	values := make(map[T][]int)
+	if len(Slice1) == 0 || len(Slice2) == 0 {
+		return values, false
+	}
+	for _, valueInSlice1 := range UniqueSliceComplexTol(Slice1, Tol) {
+		// NaN is never equal to itself as a map key, so the indices are collected before being assigned.
+		var indices []int
+		for index, valueInSlice2 := range Slice2 {
+			if isWithinComplexTolerance(valueInSlice1, valueInSlice2, Tol) {
+				indices = append(indices, index)
+			}
+		}
+		if len(indices) > 0 {
+			values[valueInSlice1] = indices
+		}
+	}
+	return values, len(values) > 0
+}
+
+// WhichValuesInComplex checks which values of one complex slice are in another slice. It works with slices of any complex type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first or the second (or both) slice is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInComplex[T Complex](Slice1, Slice2 []T, Epsilon float64) (map[T][]int, bool) {
+	return WhichValuesInComplexTol(Slice1, Slice2, ModulusTolerance(Epsilon))
+}
+
+// AreEqualSlicesComplexTol compares two slices of any complex type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// The function compares both values and ordering of the slices.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesComplexTol[T Complex](Slice1, Slice2 []T, Tol ComplexTolerance) bool {
+	if len(Slice1) != len(Slice2) {
+		return false
+	}
+	for i := range Slice1 {
+		if !isWithinComplexTolerance(Slice1[i], Slice2[i], Tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// AreEqualSlicesComplex compares two slices of any complex type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// The function compares both values and ordering of the slices.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesComplex[T Complex](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AreEqualSlicesComplexTol(Slice1, Slice2, ModulusTolerance(Epsilon))
+}
+
+// AreEqualSortedSlicesComplexTol compares two slices of any complex type, ignoring their ordering.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// Since complex numbers cannot be sorted, the slices are equal when each value of Slice1 can be paired with
+// a different value of Slice2 within the tolerance. When both slices has zero length, true is returned.
+// The function does not modify the slices.
+func AreEqualSortedSlicesComplexTol[T Complex](Slice1, Slice2 []T, Tol ComplexTolerance) bool {
+	if len(Slice1) != len(Slice2) {
+		return false
+	}
+	matchOf := make(map[int]int, len(Slice2))
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j := range Slice2 {
+			if visited[j] || !isWithinComplexTolerance(Slice1[i], Slice2[j], Tol) {
+				continue
+			}
+			visited[j] = true
+			if other, ok := matchOf[j]; !ok || augment(other, visited) {
+				matchOf[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range Slice1 {
+		if !augment(i, make([]bool, len(Slice2))) {
+			return false
+		}
+	}
+	return true
+}
+
+// AreEqualSortedSlicesComplex compares two slices of any complex type, ignoring their ordering.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When both slices has zero length, true is returned. The function does not modify the slices.
+func AreEqualSortedSlicesComplex[T Complex](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AreEqualSortedSlicesComplexTol(Slice1, Slice2, ModulusTolerance(Epsilon))
+}
+
+// UniqueSliceComplexTol returns a slice with unique elements of a slice of any complex type,
+// keeping the first one of any values that are duplicates of each other.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// If the slice has no elements, the function returns an empty slice.
+func UniqueSliceComplexTol[T Complex](Slice []T, Tol ComplexTolerance) []T {
+	unique := make([]T, 0, len(Slice))
+	for _, value := range Slice {
+		duplicated := false
+		for _, kept := range unique {
+			if isWithinComplexTolerance(value, kept, Tol) || isWithinComplexTolerance(kept, value, Tol) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// UniqueSliceComplex returns a slice with unique elements of a slice of any complex type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueSliceComplex[T Complex](Slice []T, Epsilon float64) []T {
+	return UniqueSliceComplexTol(Slice, ModulusTolerance(Epsilon))
+}
+
+// IsUniqueSliceComplexTol checks if all elements of a slice of any complex type are unique.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// If the slice has no elements, the function returns true.
+func IsUniqueSliceComplexTol[T Complex](Slice []T, Tol ComplexTolerance) bool {
+	return len(UniqueSliceComplexTol(Slice, Tol)) == len(Slice)
+}
+
+// IsUniqueSliceComplex checks if all elements of a slice of any complex type are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// If the slice has no elements, the function returns true.
+func IsUniqueSliceComplex[T Complex](Slice []T, Epsilon float64) bool {
+	return IsUniqueSliceComplexTol(Slice, ModulusTolerance(Epsilon))
+}
+
+// AreEqualMapsComplexTol compares two maps of any comparable key type and any complex value type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+func AreEqualMapsComplexTol[K comparable, V Complex](Map1, Map2 map[K]V, Tol ComplexTolerance) bool {
+	if len(Map1) != len(Map2) {
+		return false
+	}
+	for key, value1 := range Map1 {
+		value2, ok := Map2[key]
+		if !ok || !isWithinComplexTolerance(value1, value2, Tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// AreEqualMapsComplex compares two maps of any comparable key type and any complex value type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func AreEqualMapsComplex[K comparable, V Complex](Map1, Map2 map[K]V, Epsilon float64) bool {
+	return AreEqualMapsComplexTol(Map1, Map2, ModulusTolerance(Epsilon))
+}
+
+// IsValueInMapComplexTol checks if a complex number (X) is among the map's values.
+// It works with maps of any comparable key type and any complex value type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are in no particular order; use IsValueInMapSortedComplexTol to get them sorted.
+func IsValueInMapComplexTol[K comparable, V Complex](X V, Map map[K]V, Tol ComplexTolerance) ([]K, bool) {
+	keys := make([]K, 0)
+	for key, value := range Map {
+		if isWithinComplexTolerance(X, value, Tol) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, len(keys) > 0
+}
+
+// IsValueInMapComplex checks if a complex number (X) is among the map's values.
+// It works with maps of any comparable key type and any complex value type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are in no particular order; use IsValueInMapSortedComplex to get them sorted.
+func IsValueInMapComplex[K comparable, V Complex](X V, Map map[K]V, Epsilon float64) ([]K, bool) {
+	return IsValueInMapComplexTol(X, Map, ModulusTolerance(Epsilon))
+}
+
+// IsValueInMapSortedComplexTol checks if a complex number (X) is among the map's values.
+// It works with maps of any ordered key type and any complex value type.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// Returns a tuple of slice providing the map's keys that have this value, sorted in increasing order,
+// and a bool value (true if the returned slice is not empty).
+func IsValueInMapSortedComplexTol[K Ordered, V Complex](X V, Map map[K]V, Tol ComplexTolerance) ([]K, bool) {
+	keys, exists := IsValueInMapComplexTol(X, Map, Tol)
+	return sortKeys(keys), exists
+}
+
+// IsValueInMapSortedComplex checks if a complex number (X) is among the map's values.
+// It works with maps of any ordered key type and any complex value type.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Returns a tuple of slice providing the map's keys that have this value, sorted in increasing order,
+// and a bool value (true if the returned slice is not empty).
+func IsValueInMapSortedComplex[K Ordered, V Complex](X V, Map map[K]V, Epsilon float64) ([]K, bool) {
+	return IsValueInMapSortedComplexTol(X, Map, ModulusTolerance(Epsilon))
+}
+
+// IsUniqueMapComplexTol checks if all values of a map of any comparable key type and any complex value type are unique.
+// The Tol parameter sets the tolerance of the comparison of two complex numbers (see ComplexTolerance).
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapComplexTol[K comparable, V Complex](Map map[K]V, Tol ComplexTolerance) bool {
+	values := make([]V, 0, len(Map))
+	for _, value := range Map {
+		values = append(values, value)
+	}
+	return IsUniqueSliceComplexTol(values, Tol)
+}
+
+// IsUniqueMapComplex checks if all values of a map of any comparable key type and any complex value type are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapComplex[K comparable, V Complex](Map map[K]V, Epsilon float64) bool {
+	return IsUniqueMapComplexTol(Map, ModulusTolerance(Epsilon))
+}
+
+// IsValueInComplex128Slice checks if a complex128 (X) is in a slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func IsValueInComplex128Slice(X complex128, Slice []complex128, Epsilon float64) bool {
+	return IsValueInComplex(X, Slice, Epsilon)
+}
+
+// AllValuesInComplex128Slice checks if all values of one complex128 slice are in another slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInComplex128Slice(Slice1, Slice2 []complex128, Epsilon float64) bool {
+	return AllValuesInComplex(Slice1, Slice2, Epsilon)
+}
+
+// AnyValueInComplex128Slice checks if any of the values of one complex128 slice is in another slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInComplex128Slice(Slice1, Slice2 []complex128, Epsilon float64) bool {
+	return AnyValueInComplex(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesInComplex128Slice checks which values of one complex128 slice are in another slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInComplex128Slice(Slice1, Slice2 []complex128, Epsilon float64) (map[complex128][]int, bool) {
+	return WhichValuesInComplex(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSlicesComplex128 compares two complex128 slices, taking into account both their values and their ordering.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesComplex128(Slice1, Slice2 []complex128, Epsilon float64) bool {
+	return AreEqualSlicesComplex(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSortedSlicesComplex128 compares two complex128 slices, ignoring their ordering.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesComplex128(Slice1, Slice2 []complex128, Epsilon float64) bool {
+	return AreEqualSortedSlicesComplex(Slice1, Slice2, Epsilon)
+}
+
+// IsUniqueComplex128Slice checks if all elements of a complex128 slice are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// If the slice has no elements, the function returns true.
+func IsUniqueComplex128Slice(Slice []complex128, Epsilon float64) bool {
+	return IsUniqueSliceComplex(Slice, Epsilon)
+}
+
+// UniqueComplex128Slice returns a slice with unique elements of a complex128 slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueComplex128Slice(Slice []complex128, Epsilon float64) []complex128 {
+	return UniqueSliceComplex(Slice, Epsilon)
+}
+
+// AreEqualMapsStringComplex128 compares two maps map[string]complex128.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func AreEqualMapsStringComplex128(Map1, Map2 map[string]complex128, Epsilon float64) bool {
+	return AreEqualMapsComplex(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapStringComplex128 checks if a complex128 (X) is among the map's values.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringComplex128(X complex128, Map map[string]complex128, Epsilon float64) ([]string, bool) {
+	return IsValueInMapSortedComplex(X, Map, Epsilon)
+}
+
+// IsUniqueMapStringComplex128 checks if all values of map[string]complex128 are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringComplex128(Map map[string]complex128, Epsilon float64) bool {
+	return IsUniqueMapComplex(Map, Epsilon)
+}
+
+// AreEqualMapsIntComplex128 compares two maps map[int]complex128.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func AreEqualMapsIntComplex128(Map1, Map2 map[int]complex128, Epsilon float64) bool {
+	return AreEqualMapsComplex(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapIntComplex128 checks if a complex128 (X) is among the map's values.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntComplex128(X complex128, Map map[int]complex128, Epsilon float64) ([]int, bool) {
+	return IsValueInMapSortedComplex(X, Map, Epsilon)
+}
+
+// IsUniqueMapIntComplex128 checks if all values of map[int]complex128 are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntComplex128(Map map[int]complex128, Epsilon float64) bool {
+	return IsUniqueMapComplex(Map, Epsilon)
+}
+
+// IsValueInComplex64Slice checks if a complex64 (X) is in a slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func IsValueInComplex64Slice(X complex64, Slice []complex64, Epsilon float64) bool {
+	return IsValueInComplex(X, Slice, Epsilon)
+}
+
+// AllValuesInComplex64Slice checks if all values of one complex64 slice are in another slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInComplex64Slice(Slice1, Slice2 []complex64, Epsilon float64) bool {
+	return AllValuesInComplex(Slice1, Slice2, Epsilon)
+}
+
+// AnyValueInComplex64Slice checks if any of the values of one complex64 slice is in another slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInComplex64Slice(Slice1, Slice2 []complex64, Epsilon float64) bool {
+	return AnyValueInComplex(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesInComplex64Slice checks which values of one complex64 slice are in another slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInComplex64Slice(Slice1, Slice2 []complex64, Epsilon float64) (map[complex64][]int, bool) {
+	return WhichValuesInComplex(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSlicesComplex64 compares two complex64 slices, taking into account both their values and their ordering.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesComplex64(Slice1, Slice2 []complex64, Epsilon float64) bool {
+	return AreEqualSlicesComplex(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSortedSlicesComplex64 compares two complex64 slices, ignoring their ordering.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesComplex64(Slice1, Slice2 []complex64, Epsilon float64) bool {
+	return AreEqualSortedSlicesComplex(Slice1, Slice2, Epsilon)
+}
+
+// IsUniqueComplex64Slice checks if all elements of a complex64 slice are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// If the slice has no elements, the function returns true.
+func IsUniqueComplex64Slice(Slice []complex64, Epsilon float64) bool {
+	return IsUniqueSliceComplex(Slice, Epsilon)
+}
+
+// UniqueComplex64Slice returns a slice with unique elements of a complex64 slice.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueComplex64Slice(Slice []complex64, Epsilon float64) []complex64 {
+	return UniqueSliceComplex(Slice, Epsilon)
+}
+
+// AreEqualMapsStringComplex64 compares two maps map[string]complex64.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func AreEqualMapsStringComplex64(Map1, Map2 map[string]complex64, Epsilon float64) bool {
+	return AreEqualMapsComplex(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapStringComplex64 checks if a complex64 (X) is among the map's values.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringComplex64(X complex64, Map map[string]complex64, Epsilon float64) ([]string, bool) {
+	return IsValueInMapSortedComplex(X, Map, Epsilon)
+}
+
+// IsUniqueMapStringComplex64 checks if all values of map[string]complex64 are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringComplex64(Map map[string]complex64, Epsilon float64) bool {
+	return IsUniqueMapComplex(Map, Epsilon)
+}
+
+// AreEqualMapsIntComplex64 compares two maps map[int]complex64.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+func AreEqualMapsIntComplex64(Map1, Map2 map[int]complex64, Epsilon float64) bool {
+	return AreEqualMapsComplex(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapIntComplex64 checks if a complex64 (X) is among the map's values.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntComplex64(X complex64, Map map[int]complex64, Epsilon float64) ([]int, bool) {
+	return IsValueInMapSortedComplex(X, Map, Epsilon)
+}
+
+// IsUniqueMapIntComplex64 checks if all values of map[int]complex64 are unique.
+// The Epsilon parameter bounds the modulus of the difference of two complex numbers.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntComplex64(Map map[int]complex64, Epsilon float64) bool {
+	return IsUniqueMapComplex(Map, Epsilon)
+}
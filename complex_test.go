@@ -0,0 +1,104 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestComplexTolerance(t *testing.T) {
+	nan := math.NaN()
+	inf := math.Inf(1)
+	tests := []struct {
+		X, Y complex128
+		Tol  ComplexTolerance
+		want bool
+	}{
+		{1 + 1i, 1 + 1i, ModulusTolerance(0), true},
+		{1 + 1i, 1.03 + 1.04i, ModulusTolerance(.051), true},
+		{1 + 1i, 1.04 + 1.04i, ModulusTolerance(.05), false},
+		{1 + 1i, 1.04 + 1.04i, PerPartTolerance(AbsTolerance(.05)), true},
+		{1 + 1i, 1.06 + 1i, PerPartTolerance(AbsTolerance(.05)), false},
+		{100 + 0i, 0 + 101i, ComplexTolerance{Tolerance: RelTolerance(.01)}, false},
+		{100 + 100i, 100.5 + 100.5i, ComplexTolerance{Tolerance: RelTolerance(.01)}, true},
+		{complex(nan, 1), complex(nan, 1), ModulusTolerance(1), false},
+		{complex(nan, 1), complex(nan, 1), ComplexTolerance{Tolerance: AbsTolerance(1).WithPolicy(MissingDataPolicy)}, true},
+		{complex(inf, 1), complex(inf, 1.5), ComplexTolerance{Tolerance: AbsTolerance(1).WithPolicy(MissingDataPolicy)}, true},
+		{complex(inf, 1), complex(inf, 3), ComplexTolerance{Tolerance: AbsTolerance(1).WithPolicy(MissingDataPolicy)}, false},
+	}
+	for _, test := range tests {
+		if got := test.Tol.Equal(test.X, test.Y); got != test.want {
+			t.Errorf("%+v.Equal(%v, %v) = %v; want %v", test.Tol, test.X, test.Y, got, test.want)
+		}
+	}
+}
+
+func TestComplex128Slices(t *testing.T) {
+	fft := []complex128{1 + 2i, 3 - 1i, 1 + 2i}
+	other := []complex128{3.001 - 1i, 1 + 2.001i, 1.002 + 2i}
+	if !IsValueInComplex128Slice(3-1i, other, .01) || IsValueInComplex128Slice(3-1.1i, other, .01) {
+		t.Errorf("IsValueInComplex128Slice should check if the value is in %v", other)
+	}
+	if !AllValuesInComplex128Slice(fft, other, .01) || AllValuesInComplex128Slice(fft, other, 0) {
+		t.Errorf("AllValuesInComplex128Slice should use Epsilon")
+	}
+	if !AnyValueInComplex128Slice([]complex128{5, 1 + 2i}, other, .01) || AnyValueInComplex128Slice([]complex128{5}, other, .01) {
+		t.Errorf("AnyValueInComplex128Slice should check if any value is in %v", other)
+	}
+	which, ok := WhichValuesInComplex128Slice(fft, other, .01)
+	if !ok || len(which) != 2 || !AreEqualSlicesInt(which[1+2i], []int{1, 2}) || !AreEqualSlicesInt(which[3-1i], []int{0}) {
+		t.Errorf("WhichValuesInComplex128Slice(%v, %v, .01) = %v, %v", fft, other, which, ok)
+	}
+	if AreEqualSlicesComplex128(fft, other, .01) || !AreEqualSlicesComplex128(fft, []complex128{1 + 2i, 3 - 1i, 1.001 + 2i}, .01) {
+		t.Errorf("AreEqualSlicesComplex128 should compare both values and ordering")
+	}
+	if !AreEqualSortedSlicesComplex128(fft, other, .01) || AreEqualSortedSlicesComplex128(fft, []complex128{1 + 2i, 3 - 1i, 3 - 1i}, .01) {
+		t.Errorf("AreEqualSortedSlicesComplex128 should pair the values regardless of their ordering")
+	}
+	if IsUniqueComplex128Slice(fft, 0) || !IsUniqueComplex128Slice(UniqueComplex128Slice(fft, 0), 0) || len(UniqueComplex128Slice(other, .01)) != 2 {
+		t.Errorf("IsUniqueComplex128Slice and UniqueComplex128Slice should remove duplicates within Epsilon")
+	}
+	if !AreEqualSlicesComplex128([]complex128{}, []complex128{}, 0) || !AreEqualSortedSlicesComplex128(nil, []complex128{}, 0) {
+		t.Errorf("empty slices should be equal")
+	}
+}
+
+func TestAreEqualSortedSlicesComplexNeedsMatching(t *testing.T) {
+	// A greedy pairing of 1 with 1.05 would leave 1.1 without a pair.
+	Slice1 := []complex64{1, 1.05}
+	Slice2 := []complex64{1.05, 1.1}
+	if !AreEqualSortedSlicesComplex(Slice1, Slice2, .06) {
+		t.Errorf("AreEqualSortedSlicesComplex(%v, %v, .06) should be true", Slice1, Slice2)
+	}
+	if !AreEqualSortedSlicesComplex64(Slice1, Slice2, .06) || AreEqualSortedSlicesComplex64(Slice1, Slice2, .04) {
+		t.Errorf("AreEqualSortedSlicesComplex64 should use Epsilon")
+	}
+}
+
+func TestComplexMaps(t *testing.T) {
+	Map1 := map[string]complex128{"a": 1 + 1i, "b": 2 - 2i}
+	Map2 := map[string]complex128{"a": 1.001 + 1i, "b": 2 - 2.001i}
+	if !AreEqualMapsStringComplex128(Map1, Map2, .01) || AreEqualMapsStringComplex128(Map1, Map2, 0) {
+		t.Errorf("AreEqualMapsStringComplex128 should use Epsilon")
+	}
+	if AreEqualMapsStringComplex128(Map1, map[string]complex128{"a": 1 + 1i, "c": 2 - 2i}, .01) {
+		t.Errorf("AreEqualMapsStringComplex128 should check the keys")
+	}
+	keys, ok := IsValueInMapIntComplex64(1+1i, map[int]complex64{3: 1 + 1i, 1: 1.001 + 1i, 2: 5}, .01)
+	if !ok || !AreEqualSlicesInt(keys, []int{1, 3}) {
+		t.Errorf("IsValueInMapIntComplex64 = %v, %v; want [1 3] true", keys, ok)
+	}
+	if IsUniqueMapStringComplex128(Map2, 0) != true || IsUniqueMapIntComplex128(map[int]complex128{1: 1i, 2: 1.001i}, .01) {
+		t.Errorf("IsUniqueMapStringComplex128 and IsUniqueMapIntComplex128 should use Epsilon")
+	}
+}
+
+func ExampleAreEqualSlicesComplex128() {
+	expected := []complex128{1 + 2i, 3 - 1i}
+	got := []complex128{1.0005 + 2i, 3 - 1.0005i}
+	fmt.Println(AreEqualSlicesComplex128(got, expected, 1e-3))
+	fmt.Println(AreEqualSlicesComplexTol(got, expected, PerPartTolerance(AbsTolerance(1e-4))))
+	// Output:
+	// true
+	// false
+}
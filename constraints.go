@@ -0,0 +1,30 @@
+package check
+
+// Float is a constraint that permits any floating-point type.
+// Functions working with floats compare them using the Epsilon parameter.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Ordered is a constraint that permits any type supporting the < operator,
+// so that the values can be sorted.
+type Ordered interface {
+	Integer | Float | ~string
+}
+
+// less reports whether X should be sorted before Y. Like sort.Float64s, it puts NaN values first.
+func less[T Ordered](X, Y T) bool {
+	return X < Y || (X != X && Y == Y)
+}
+
+// Complex is a constraint that permits any complex type.
+// Functions working with complex numbers compare them using the Epsilon parameter or a ComplexTolerance.
+type Complex interface {
+	~complex64 | ~complex128
+}
@@ -0,0 +1,232 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Entry is a key-value pair that is present in only one of two compared slices or maps.
+// For slices, Key is the element's index.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Change is a key whose values differ between two compared slices or maps.
+// For slices, Key is the element's index. Value1 comes from the first slice (or map), Value2 from the second one.
+// For floats, Delta is the absolute difference between the two values; otherwise, it is 0.
+type Change[K comparable, V any] struct {
+	Key    K
+	Value1 V
+	Value2 V
+	Delta  float64
+}
+
+// Diff describes the differences between two slices or two maps, the first one being compared against the second one.
+// Missing lists the entries of the second slice (or map) that are absent from the first one,
+// Extra lists the entries of the first slice (or map) that are absent from the second one,
+// and Changed lists the keys (for slices, indices) present in both but having different values.
+// All of them are sorted by key.
+// For floats, Tolerance is the tolerance used in the comparison of two floats.
+type Diff[K Ordered, V any] struct {
+	Missing   []Entry[K, V]
+	Extra     []Entry[K, V]
+	Changed   []Change[K, V]
+	Tolerance Tolerance
+
+	isSlice bool
+	isFloat bool
+}
+
+// IsEmpty checks if the compared slices (or maps) have no differences.
+func (d Diff[K, V]) IsEmpty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Changed) == 0
+}
+
+// String renders the differences one per line, so that the diff can be used directly in t.Errorf.
+// When there are no differences, it returns "no differences".
+// For floats, a changed value is followed by the absolute difference and the tolerance used; the difference alone
+// need not explain the change, since NaN, infinities and ULP tolerances are not compared by the absolute difference.
+func (d Diff[K, V]) String() string {
+	if d.IsEmpty() {
+		return "no differences"
+	}
+	name := "key"
+	if d.isSlice {
+		name = "index"
+	}
+	lines := make([]string, 0, len(d.Missing)+len(d.Extra)+len(d.Changed))
+	for _, entry := range d.Missing {
+		lines = append(lines, fmt.Sprintf("missing %s %v: %v", name, entry.Key, entry.Value))
+	}
+	for _, entry := range d.Extra {
+		lines = append(lines, fmt.Sprintf("extra %s %v: %v", name, entry.Key, entry.Value))
+	}
+	for _, change := range d.Changed {
+		line := fmt.Sprintf("changed %s %v: %v != %v", name, change.Key, change.Value1, change.Value2)
+		if d.isFloat {
+			line += fmt.Sprintf(" (|difference| = %v; %v)", change.Delta, d.Tolerance)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffSlices compares two slices of any comparable type, element by element, and returns their differences.
+// The second slice is considered the reference, so when the first slice is longer, its additional elements are Extra,
+// and when it is shorter, the additional elements of the second slice are Missing.
+func DiffSlices[T comparable](Slice1, Slice2 []T) Diff[int, T] {
+	return diffSlices(Slice1, Slice2, func(X, Y T) (bool, float64) { return X == Y, 0 })
+}
+
+// DiffSlicesTol compares two slices of any float type, element by element, and returns their differences.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The second slice is considered the reference (see DiffSlices).
+func DiffSlicesTol[T Float](Slice1, Slice2 []T, Tol Tolerance) Diff[int, T] {
+	d := diffSlices(Slice1, Slice2, func(X, Y T) (bool, float64) {
+		return isWithinTolerance(X, Y, Tol), math.Abs(float64(X) - float64(Y))
+	})
+	d.Tolerance = Tol
+	d.isFloat = true
+	return d
+}
+
+// DiffSlicesFloat compares two slices of any float type, element by element, and returns their differences.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The second slice is considered the reference (see DiffSlices).
+func DiffSlicesFloat[T Float](Slice1, Slice2 []T, Epsilon float64) Diff[int, T] {
+	return DiffSlicesTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// DiffMaps compares two maps of any ordered key type and any comparable value type, and returns their differences.
+// The second map is considered the reference, so its keys absent from the first map are Missing,
+// and the keys of the first map absent from the second one are Extra.
+func DiffMaps[K Ordered, V comparable](Map1, Map2 map[K]V) Diff[K, V] {
+	return diffMaps(Map1, Map2, func(X, Y V) (bool, float64) { return X == Y, 0 })
+}
+
+// DiffMapsTol compares two maps of any ordered key type and any float value type, and returns their differences.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The second map is considered the reference (see DiffMaps).
+func DiffMapsTol[K Ordered, V Float](Map1, Map2 map[K]V, Tol Tolerance) Diff[K, V] {
+	d := diffMaps(Map1, Map2, func(X, Y V) (bool, float64) {
+		return isWithinTolerance(X, Y, Tol), math.Abs(float64(X) - float64(Y))
+	})
+	d.Tolerance = Tol
+	d.isFloat = true
+	return d
+}
+
+// DiffMapsFloat compares two maps of any ordered key type and any float value type, and returns their differences.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The second map is considered the reference (see DiffMaps).
+func DiffMapsFloat[K Ordered, V Float](Map1, Map2 map[K]V, Epsilon float64) Diff[K, V] {
+	return DiffMapsTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// diffSlices compares two slices using the equal function, which also returns the absolute difference of the values.
+func diffSlices[T any](Slice1, Slice2 []T, equal func(X, Y T) (bool, float64)) Diff[int, T] {
+	d := Diff[int, T]{isSlice: true}
+	for i := range Slice1 {
+		if i >= len(Slice2) {
+			d.Extra = append(d.Extra, Entry[int, T]{i, Slice1[i]})
+			continue
+		}
+		if ok, delta := equal(Slice1[i], Slice2[i]); !ok {
+			d.Changed = append(d.Changed, Change[int, T]{i, Slice1[i], Slice2[i], delta})
+		}
+	}
+	for i := len(Slice1); i < len(Slice2); i++ {
+		d.Missing = append(d.Missing, Entry[int, T]{i, Slice2[i]})
+	}
+	return d
+}
+
+// diffMaps compares two maps using the equal function, which also returns the absolute difference of the values.
+func diffMaps[K Ordered, V any](Map1, Map2 map[K]V, equal func(X, Y V) (bool, float64)) Diff[K, V] {
+	d := Diff[K, V]{}
+	for key, value1 := range Map1 {
+		value2, ok := Map2[key]
+		if !ok {
+			d.Extra = append(d.Extra, Entry[K, V]{key, value1})
+			continue
+		}
+		if ok, delta := equal(value1, value2); !ok {
+			d.Changed = append(d.Changed, Change[K, V]{key, value1, value2, delta})
+		}
+	}
+	for key, value2 := range Map2 {
+		if _, ok := Map1[key]; !ok {
+			d.Missing = append(d.Missing, Entry[K, V]{key, value2})
+		}
+	}
+	sort.Slice(d.Missing, func(i, j int) bool { return less(d.Missing[i].Key, d.Missing[j].Key) })
+	sort.Slice(d.Extra, func(i, j int) bool { return less(d.Extra[i].Key, d.Extra[j].Key) })
+	sort.Slice(d.Changed, func(i, j int) bool { return less(d.Changed[i].Key, d.Changed[j].Key) })
+	return d
+}
+
+// DiffSlicesInt compares two int slices and returns their differences; see DiffSlices.
+func DiffSlicesInt(Slice1, Slice2 []int) Diff[int, int] {
+	return DiffSlices(Slice1, Slice2)
+}
+
+// DiffSlicesString compares two string slices and returns their differences; see DiffSlices.
+func DiffSlicesString(Slice1, Slice2 []string) Diff[int, string] {
+	return DiffSlices(Slice1, Slice2)
+}
+
+// DiffSlicesFloat64 compares two float64 slices and returns their differences; see DiffSlices.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func DiffSlicesFloat64(Slice1, Slice2 []float64, Epsilon float64) Diff[int, float64] {
+	return DiffSlicesFloat(Slice1, Slice2, Epsilon)
+}
+
+// DiffMapsStringFloat64 compares two maps map[string]float64 and returns their differences; see DiffMaps.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func DiffMapsStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) Diff[string, float64] {
+	return DiffMapsFloat(Map1, Map2, Epsilon)
+}
+
+// DiffMapsStringInt compares two maps map[string]int and returns their differences; see DiffMaps.
+func DiffMapsStringInt(Map1, Map2 map[string]int) Diff[string, int] {
+	return DiffMaps(Map1, Map2)
+}
+
+// DiffMapsStringString compares two maps map[string]string and returns their differences; see DiffMaps.
+func DiffMapsStringString(Map1, Map2 map[string]string) Diff[string, string] {
+	return DiffMaps(Map1, Map2)
+}
+
+// DiffMapsIntFloat64 compares two maps map[int]float64 and returns their differences; see DiffMaps.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func DiffMapsIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) Diff[int, float64] {
+	return DiffMapsFloat(Map1, Map2, Epsilon)
+}
+
+// DiffMapsIntInt compares two maps map[int]int and returns their differences; see DiffMaps.
+func DiffMapsIntInt(Map1, Map2 map[int]int) Diff[int, int] {
+	return DiffMaps(Map1, Map2)
+}
+
+// DiffMapsIntString compares two maps map[int]string and returns their differences; see DiffMaps.
+func DiffMapsIntString(Map1, Map2 map[int]string) Diff[int, string] {
+	return DiffMaps(Map1, Map2)
+}
+
+// DiffSlicesBool compares two bool slices and returns their differences; see DiffSlices.
+func DiffSlicesBool(Slice1, Slice2 []bool) Diff[int, bool] {
+	return DiffSlices(Slice1, Slice2)
+}
+
+// DiffMapsStringBool compares two maps map[string]bool and returns their differences; see DiffMaps.
+func DiffMapsStringBool(Map1, Map2 map[string]bool) Diff[string, bool] {
+	return DiffMaps(Map1, Map2)
+}
+
+// DiffMapsIntBool compares two maps map[int]bool and returns their differences; see DiffMaps.
+func DiffMapsIntBool(Map1, Map2 map[int]bool) Diff[int, bool] {
+	return DiffMaps(Map1, Map2)
+}
@@ -0,0 +1,123 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestDiffSlicesInt(t *testing.T) {
+	tests := []struct {
+		slice1          []int
+		slice2          []int
+		expectedMissing []int
+		expectedExtra   []int
+		expectedChanged []int
+	}{
+		{[]int{}, []int{}, nil, nil, nil},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, nil, nil, nil},
+		{[]int{1, 2, 3}, []int{1, 5, 3}, nil, nil, []int{1}},
+		{[]int{1, 2}, []int{1, 2, 3, 4}, []int{2, 3}, nil, nil},
+		{[]int{1, 2, 3, 4}, []int{0, 2}, nil, []int{2, 3}, []int{0}},
+	}
+	for _, test := range tests {
+		d := DiffSlicesInt(test.slice1, test.slice2)
+		missing, extra, changed := diffKeys(d)
+		if !AreEqualSlices(missing, test.expectedMissing) ||
+			!AreEqualSlices(extra, test.expectedExtra) ||
+			!AreEqualSlices(changed, test.expectedChanged) {
+			t.Errorf("DiffSlicesInt(%v, %v) = %v; want missing %v, extra %v, changed %v",
+				test.slice1, test.slice2, d, test.expectedMissing, test.expectedExtra, test.expectedChanged)
+		}
+		if d.IsEmpty() != AreEqualSlicesInt(test.slice1, test.slice2) {
+			t.Errorf("DiffSlicesInt(%v, %v).IsEmpty() should agree with AreEqualSlicesInt", test.slice1, test.slice2)
+		}
+	}
+}
+
+func TestDiffSlicesFloat64(t *testing.T) {
+	d := DiffSlicesFloat64([]float64{1, 2, 3}, []float64{1.05, 2.5, 3}, .1)
+	if len(d.Changed) != 1 || d.Changed[0].Key != 1 || d.Changed[0].Delta != .5 {
+		t.Errorf("DiffSlicesFloat64 returned %v; want index 1 changed by .5", d.Changed)
+	}
+	if !DiffSlicesFloat64([]float64{1, 2}, []float64{1.05, 2}, .1).IsEmpty() {
+		t.Errorf("DiffSlicesFloat64 should be empty for values within Epsilon")
+	}
+}
+
+func ExampleDiffSlicesInt() {
+	fmt.Println(DiffSlicesInt([]int{1, 2, 3}, []int{1, 5, 3, 4}))
+	fmt.Println(DiffSlicesInt([]int{1, 2}, []int{1, 2}))
+	// Output:
+	// missing index 3: 4
+	// changed index 1: 2 != 5
+	// no differences
+}
+
+func TestDiffStringNaN(t *testing.T) {
+	d := DiffSlicesFloat([]float64{math.NaN()}, []float64{1}, .1)
+	want := "changed index 0: NaN != 1 (|difference| = NaN; epsilon = 0.1)"
+	if got := d.String(); got != want {
+		t.Errorf("DiffSlicesFloat([NaN], [1], .1).String() = %q; want %q", got, want)
+	}
+}
+
+func TestDiffMapsStringInt(t *testing.T) {
+	tests := []struct {
+		map1            map[string]int
+		map2            map[string]int
+		expectedMissing []string
+		expectedExtra   []string
+		expectedChanged []string
+	}{
+		{map[string]int{}, map[string]int{}, nil, nil, nil},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, nil, nil, nil},
+		{map[string]int{"a": 0}, map[string]int{"b": 0}, []string{"b"}, []string{"a"}, nil},
+		{map[string]int{"a": 1, "b": 2, "d": 4}, map[string]int{"a": 1, "b": 3, "c": 3}, []string{"c"}, []string{"d"}, []string{"b"}},
+		{map[string]int{"c": 1, "b": 1, "a": 1}, map[string]int{"a": 2, "b": 2, "c": 2}, nil, nil, []string{"a", "b", "c"}},
+	}
+	for _, test := range tests {
+		d := DiffMapsStringInt(test.map1, test.map2)
+		missing, extra, changed := diffKeys(d)
+		if !AreEqualSlices(missing, test.expectedMissing) ||
+			!AreEqualSlices(extra, test.expectedExtra) ||
+			!AreEqualSlices(changed, test.expectedChanged) {
+			t.Errorf("DiffMapsStringInt(%v, %v) = %v; want missing %v, extra %v, changed %v",
+				test.map1, test.map2, d, test.expectedMissing, test.expectedExtra, test.expectedChanged)
+		}
+	}
+}
+
+func ExampleDiffMapsStringFloat64() {
+	got := map[string]float64{"a": 1.01, "b": 2, "c": 3}
+	want := map[string]float64{"a": 1, "b": 2.5, "d": 4}
+	fmt.Println(DiffMapsStringFloat64(got, want, .1))
+	// Output:
+	// missing key d: 4
+	// extra key c: 3
+	// changed key b: 2 != 2.5 (|difference| = 0.5; epsilon = 0.1)
+}
+
+func TestDiffMapsIntString(t *testing.T) {
+	d := DiffMapsIntString(map[int]string{1: "a", 2: "b"}, map[int]string{1: "a", 2: "c", 3: "d"})
+	if len(d.Missing) != 1 || d.Missing[0] != (Entry[int, string]{3, "d"}) {
+		t.Errorf("DiffMapsIntString returned missing %v; want [{3 d}]", d.Missing)
+	}
+	if len(d.Changed) != 1 || d.Changed[0] != (Change[int, string]{2, "b", "c", 0}) {
+		t.Errorf("DiffMapsIntString returned changed %v; want [{2 b c 0}]", d.Changed)
+	}
+}
+
+// diffKeys returns the keys of the missing, extra and changed entries of a diff.
+func diffKeys[K Ordered, V any](d Diff[K, V]) (missing, extra, changed []K) {
+	for _, entry := range d.Missing {
+		missing = append(missing, entry.Key)
+	}
+	for _, entry := range d.Extra {
+		extra = append(extra, entry.Key)
+	}
+	for _, change := range d.Changed {
+		changed = append(changed, change.Key)
+	}
+	return missing, extra, changed
+}
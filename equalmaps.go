@@ -1,85 +1,169 @@
 package check
 
-import (
-	"math"
-)
-
-// AreEqualMapsStringFloat64 compares two maps map[string]float64.
-// The Epsilon parameter sets the accuracy of the comparison of two floats.
-func AreEqualMapsStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) bool {
+// AreEqualMaps compares two maps of any comparable key and value types.
+// The maps are equal when they have the same keys with the same values, so a key missing from one of the maps
+// makes them different, even if the other map has the zero value for it; see AreEqualMapsMissingAsZero.
+func AreEqualMaps[K comparable, V comparable](Map1, Map2 map[K]V) bool {
 	if len(Map1) != len(Map2) {
 		return false
 	}
-	for i := range Map1 {
-		if math.Abs(Map1[i]-Map2[i]) > Epsilon {
+	for key, value1 := range Map1 {
+		value2, ok := Map2[key]
+		if !ok || value1 != value2 {
 			return false
 		}
 	}
 	return true
 }
 
-// AreEqualMapsStringInt compares two maps map[string]int.
-func AreEqualMapsStringInt(Map1, Map2 map[string]int) bool {
+// AreEqualMapsTol compares two maps of any comparable key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// A key missing from one of the maps makes them different; see AreEqualMapsMissingAsZeroTol.
+func AreEqualMapsTol[K comparable, V Float](Map1, Map2 map[K]V, Tol Tolerance) bool {
 	if len(Map1) != len(Map2) {
 		return false
 	}
-	for i := range Map1 {
-		if Map1[i] != Map2[i] {
+	for key, value1 := range Map1 {
+		value2, ok := Map2[key]
+		if !ok || !isWithinTolerance(value1, value2, Tol) {
 			return false
 		}
 	}
 	return true
 }
 
-// AreEqualMapsStringString compares two maps map[string]string.
-func AreEqualMapsStringString(Map1, Map2 map[string]string) bool {
-	if len(Map1) != len(Map2) {
-		return false
+// AreEqualMapsFloat compares two maps of any comparable key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// A key missing from one of the maps makes them different; see AreEqualMapsMissingAsZeroFloat.
+func AreEqualMapsFloat[K comparable, V Float](Map1, Map2 map[K]V, Epsilon float64) bool {
+	return AreEqualMapsTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// AreEqualMapsMissingAsZero compares two maps of any comparable key and value types,
+// treating a key missing from one of the maps as if it had the zero value there.
+// So, map[string]int{"a": 0} equals both map[string]int{"b": 0} and map[string]int{}.
+// Note that this is broader than how AreEqualMaps worked before it started checking key presence:
+// it then returned false for maps of different lengths, while this function ignores the lengths
+// and compares both maps' keys.
+func AreEqualMapsMissingAsZero[K comparable, V comparable](Map1, Map2 map[K]V) bool {
+	for key, value1 := range Map1 {
+		if value1 != Map2[key] {
+			return false
+		}
 	}
-	for i := range Map1 {
-		if Map1[i] != Map2[i] {
+	for key, value2 := range Map2 {
+		if _, ok := Map1[key]; !ok && value2 != *new(V) {
 			return false
 		}
 	}
 	return true
 }
 
-// AreEqualMapsIntFloat64 compares two maps map[int]float64.
-// The Epsilon parameter sets the accuracy of the comparison of two floats.
-func AreEqualMapsIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) bool {
-	if len(Map1) != len(Map2) {
-		return false
+// AreEqualMapsMissingAsZeroTol compares two maps of any comparable key type and any float value type,
+// treating a key missing from one of the maps as if it had the value of 0 there.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Like AreEqualMapsMissingAsZero, it ignores the lengths of the maps, unlike the old AreEqualMaps functions.
+func AreEqualMapsMissingAsZeroTol[K comparable, V Float](Map1, Map2 map[K]V, Tol Tolerance) bool {
+	for key, value1 := range Map1 {
+		if !isWithinTolerance(value1, Map2[key], Tol) {
+			return false
+		}
 	}
-	for i := range Map1 {
-		if math.Abs(Map1[i]-Map2[i]) > Epsilon {
+	for key, value2 := range Map2 {
+		if _, ok := Map1[key]; !ok && !isWithinTolerance(0, value2, Tol) {
 			return false
 		}
 	}
 	return true
 }
 
+// AreEqualMapsMissingAsZeroFloat compares two maps of any comparable key type and any float value type,
+// treating a key missing from one of the maps as if it had the value of 0 there.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsMissingAsZeroFloat[K comparable, V Float](Map1, Map2 map[K]V, Epsilon float64) bool {
+	return AreEqualMapsMissingAsZeroTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// AreEqualMapsStringFloat64 compares two maps map[string]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// AreEqualMapsStringInt compares two maps map[string]int.
+func AreEqualMapsStringInt(Map1, Map2 map[string]int) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// AreEqualMapsStringString compares two maps map[string]string.
+func AreEqualMapsStringString(Map1, Map2 map[string]string) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// AreEqualMapsIntFloat64 compares two maps map[int]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
 // AreEqualMapsIntInt compares two maps map[int]int.
 func AreEqualMapsIntInt(Map1, Map2 map[int]int) bool {
-	if len(Map1) != len(Map2) {
-		return false
-	}
-	for i := range Map1 {
-		if Map1[i] != Map2[i] {
-			return false
-		}
-	}
-	return true
+	return AreEqualMaps(Map1, Map2)
 }
 
 // AreEqualMapsIntString compares two maps map[int]string.
 func AreEqualMapsIntString(Map1, Map2 map[int]string) bool {
-	if len(Map1) != len(Map2) {
-		return false
-	}
-	for i := range Map1 {
-		if Map1[i] != Map2[i] {
-			return false
-		}
-	}
-	return true
+	return AreEqualMaps(Map1, Map2)
+}
+
+// AreEqualMapsStringFloat64MissingAsZero compares two maps map[string]float64, treating a missing key as if it had the value of 0.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsStringFloat64MissingAsZero(Map1, Map2 map[string]float64, Epsilon float64) bool {
+	return AreEqualMapsMissingAsZeroFloat(Map1, Map2, Epsilon)
+}
+
+// AreEqualMapsStringIntMissingAsZero compares two maps map[string]int, treating a missing key as if it had the zero value.
+func AreEqualMapsStringIntMissingAsZero(Map1, Map2 map[string]int) bool {
+	return AreEqualMapsMissingAsZero(Map1, Map2)
+}
+
+// AreEqualMapsStringStringMissingAsZero compares two maps map[string]string, treating a missing key as if it had the zero value.
+func AreEqualMapsStringStringMissingAsZero(Map1, Map2 map[string]string) bool {
+	return AreEqualMapsMissingAsZero(Map1, Map2)
+}
+
+// AreEqualMapsIntFloat64MissingAsZero compares two maps map[int]float64, treating a missing key as if it had the value of 0.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsIntFloat64MissingAsZero(Map1, Map2 map[int]float64, Epsilon float64) bool {
+	return AreEqualMapsMissingAsZeroFloat(Map1, Map2, Epsilon)
+}
+
+// AreEqualMapsIntIntMissingAsZero compares two maps map[int]int, treating a missing key as if it had the zero value.
+func AreEqualMapsIntIntMissingAsZero(Map1, Map2 map[int]int) bool {
+	return AreEqualMapsMissingAsZero(Map1, Map2)
+}
+
+// AreEqualMapsIntStringMissingAsZero compares two maps map[int]string, treating a missing key as if it had the zero value.
+func AreEqualMapsIntStringMissingAsZero(Map1, Map2 map[int]string) bool {
+	return AreEqualMapsMissingAsZero(Map1, Map2)
+}
+
+// AreEqualMapsStringBool compares two maps map[string]bool.
+func AreEqualMapsStringBool(Map1, Map2 map[string]bool) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// AreEqualMapsIntBool compares two maps map[int]bool.
+func AreEqualMapsIntBool(Map1, Map2 map[int]bool) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// AreEqualMapsStringBoolMissingAsZero compares two maps map[string]bool, treating a missing key as if it had the value of false.
+func AreEqualMapsStringBoolMissingAsZero(Map1, Map2 map[string]bool) bool {
+	return AreEqualMapsMissingAsZero(Map1, Map2)
+}
+
+// AreEqualMapsIntBoolMissingAsZero compares two maps map[int]bool, treating a missing key as if it had the value of false.
+func AreEqualMapsIntBoolMissingAsZero(Map1, Map2 map[int]bool) bool {
+	return AreEqualMapsMissingAsZero(Map1, Map2)
 }
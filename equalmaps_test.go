@@ -13,6 +13,7 @@ func TestAreEqualMapsStringFloat64(t *testing.T) {
 		expected bool
 	}{
 		{map[string]float64{"1": 1.01}, map[string]float64{}, .00000001, false},
+		{map[string]float64{"a": 0}, map[string]float64{"b": 0}, .00000001, false},
 		{map[string]float64{}, map[string]float64{"1": 1.01}, .00000001, false},
 		{map[string]float64{"1": 1.01}, map[string]float64{"1": 1.01}, .00000001, true},
 		{map[string]float64{"1": 1.01}, map[string]float64{"1": 1.011}, .00000001, false},
@@ -106,6 +107,8 @@ func TestAreEqualMapsStringInt(t *testing.T) {
 		{map[string]int{}, map[string]int{"1": 1}, false},
 		{map[string]int{"1": 2}, map[string]int{"1": 1}, false},
 		{map[string]int{"1": 1}, map[string]int{"2": 1}, false},
+		{map[string]int{"a": 0}, map[string]int{"b": 0}, false},
+		{map[string]int{"a": 0, "b": 1}, map[string]int{"b": 1, "c": 0}, false},
 		{map[string]int{"1": 1, "2": 100, "3": 1}, map[string]int{"1": 1, "3": 1, "2": 100}, true},
 		{map[string]int{"1": 1, "2": 100, "3": 1}, map[string]int{"1": 1, "3": 1, "2": 101}, false},
 	}
@@ -238,3 +241,105 @@ func ExampleAreEqualMapsIntString() {
 	// true
 	// false
 }
+
+func TestAreEqualMaps(t *testing.T) {
+	tests := []struct {
+		map1     map[int64]bool
+		map2     map[int64]bool
+		expected bool
+	}{
+		{map[int64]bool{}, map[int64]bool{}, true},
+		{map[int64]bool{1: true}, map[int64]bool{}, false},
+		{map[int64]bool{1: true, 2: false}, map[int64]bool{2: false, 1: true}, true},
+		{map[int64]bool{1: true, 2: false}, map[int64]bool{2: true, 1: true}, false},
+	}
+	for _, test := range tests {
+		if AreEqualMaps(test.map1, test.map2) != test.expected {
+			t.Errorf("AreEqualMaps(%v, %v) should be %v", test.map1, test.map2, test.expected)
+		}
+	}
+}
+
+func TestAreEqualMapsFloat(t *testing.T) {
+	tests := []struct {
+		map1     map[string]float32
+		map2     map[string]float32
+		epsilon  float64
+		expected bool
+	}{
+		{map[string]float32{}, map[string]float32{}, 0, true},
+		{map[string]float32{"a": 1}, map[string]float32{"a": 1}, 0, true},
+		{map[string]float32{"a": 1}, map[string]float32{"a": 1.5}, .1, false},
+		{map[string]float32{"a": 1}, map[string]float32{"a": 1.5}, .5, true},
+	}
+	for _, test := range tests {
+		if AreEqualMapsFloat(test.map1, test.map2, test.epsilon) != test.expected {
+			t.Errorf("AreEqualMapsFloat(%v, %v, %v) should be %v", test.map1, test.map2, test.epsilon, test.expected)
+		}
+	}
+}
+
+func ExampleAreEqualMaps() {
+	fmt.Println(AreEqualMaps(map[uint8]string{1: "a", 2: "b"}, map[uint8]string{2: "b", 1: "a"}))
+	fmt.Println(AreEqualMaps(map[uint8]string{1: "a", 2: "b"}, map[uint8]string{2: "a", 1: "b"}))
+	// Output:
+	// true
+	// false
+}
+
+func TestAreEqualMapsMissingAsZero(t *testing.T) {
+	tests := []struct {
+		map1     map[string]int
+		map2     map[string]int
+		expected bool
+	}{
+		{map[string]int{}, map[string]int{}, true},
+		{map[string]int{"a": 0}, map[string]int{}, true},
+		{map[string]int{}, map[string]int{"a": 0}, true},
+		{map[string]int{"a": 0}, map[string]int{"b": 0}, true},
+		{map[string]int{"a": 1}, map[string]int{"a": 1, "b": 0}, true},
+		{map[string]int{"a": 0}, map[string]int{"b": 5}, false},
+		{map[string]int{"a": 1}, map[string]int{}, false},
+		{map[string]int{"a": 1}, map[string]int{"a": 2}, false},
+	}
+	for _, test := range tests {
+		if actual := AreEqualMapsStringIntMissingAsZero(test.map1, test.map2); actual != test.expected {
+			t.Errorf("AreEqualMapsStringIntMissingAsZero(%v, %v) = %v; want %v", test.map1, test.map2, actual, test.expected)
+		}
+		if actual := AreEqualMapsStringInt(test.map1, test.map2); actual && !test.expected {
+			t.Errorf("AreEqualMapsStringInt(%v, %v) = %v; want false", test.map1, test.map2, actual)
+		}
+	}
+}
+
+func TestAreEqualMapsMissingAsZeroFloat(t *testing.T) {
+	tests := []struct {
+		map1     map[int]float64
+		map2     map[int]float64
+		epsilon  float64
+		expected bool
+	}{
+		{map[int]float64{1: 0}, map[int]float64{2: .001}, .01, true},
+		{map[int]float64{1: 0}, map[int]float64{2: .1}, .01, false},
+		{map[int]float64{1: .005}, map[int]float64{}, .01, true},
+		{map[int]float64{1: 1}, map[int]float64{1: 1.001, 3: 0}, .01, true},
+	}
+	for _, test := range tests {
+		if actual := AreEqualMapsIntFloat64MissingAsZero(test.map1, test.map2, test.epsilon); actual != test.expected {
+			t.Errorf("AreEqualMapsIntFloat64MissingAsZero(%v, %v, %v) = %v; want %v", test.map1, test.map2, test.epsilon, actual, test.expected)
+		}
+		if actual := AreEqualMapsIntFloat64(test.map1, test.map2, test.epsilon); actual {
+			t.Errorf("AreEqualMapsIntFloat64(%v, %v, %v) = %v; want false", test.map1, test.map2, test.epsilon, actual)
+		}
+	}
+}
+
+func ExampleAreEqualMapsMissingAsZero() {
+	fmt.Println(AreEqualMaps(map[string]int{"a": 0}, map[string]int{"b": 0}))
+	fmt.Println(AreEqualMapsMissingAsZero(map[string]int{"a": 0}, map[string]int{"b": 0}))
+	fmt.Println(AreEqualMapsMissingAsZero(map[string]int{"a": 1}, map[string]int{"a": 1, "b": 0}))
+	// Output:
+	// false
+	// true
+	// true
+}
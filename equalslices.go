@@ -1,182 +1,218 @@
-/* Package check offers functions to check various conditions, including
-
-* check if all boolean values are true (in a slice and in a map)
-* check if any boolean value is true (in a slice and in a map)
-* check which of boolean values is true (in a slice and in a map)
-* check if all elements of a slice have the same value
-* check if all keys of a map have the same value
-* check if two slices are the same (either taking into account the ordering of the slices or ignoring it)
-* check if two maps are the same
-* check if a value is in a slice
-* check if several values are in a slice
-* check if any of several values are in a slice
-* check which of several values are in a slice
-* check if a key-value pair is among a map's key-values
-* check if a value is among a map's values
-* check if several values from a slice are in a map
-* check if any value from a slice are in a map
-* check which values from a slice are in a map
-* check if all key-value pairs from a map are in a map
-* check if any of key-value pairs from a map are in a map
-* check which key-value pairs from a map are in a map
-
-In addition to these checks, the package enables one to create a unique slice (that is, with unique values) out of a slice.
-
-The package works with the following slices:
-
-* []string
-* []int
-* []float64
-* []bool
-
-and maps:
-
-* map[string]string
-* map[string]int
-* map[string]float64
-* map[string]bool
-* map[int]string
-* map[int]int
-* map[int]float64
-* map[int]bool
-
-Floats are compared using an epsilon value, meaning that two floats are considered equal when their absolute difference is less than or equal to epsilon.
-Since using floats as map keys is not recommended, the package does not with with such maps.
-*/
-
-package check
-
-import (
-	"math"
-	"sort"
-)
-
-// AreEqualSlicesFloat64 compares two float64 slices.
-// The Epsilon parameter sets the accuracy of the comparison of two floats.
-// The function ignores sorting, so compares both values and sorting of the slices.
-// Thus, if the slices have the same values but different orders, they are not considered the same.
-// When both slices has zero length, true is returned.
-// If you want the function to sort the slices first, use AreEqualSortedSlicesFloat64.
-func AreEqualSlicesFloat64(Slice1, Slice2 []float64, Epsilon float64) bool {
-	if len(Slice1) == 0 && len(Slice2) == 0 {
-		return true
-	}
-	if len(Slice1) != len(Slice2) {
-		return false
-	}
-	for i := range Slice1 {
-		if math.Abs(Slice1[i]-Slice2[i]) > Epsilon {
-			return false
-		}
-	}
-	return true
-}
-
-// AreEqualSortedSlicesFloat64 compares two float64 slices.
-// The Epsilon parameter sets the accuracy of the comparison of two floats.
-// The function takes into account sorting, meaning that if they have the same values, they are considered the same
-// even if they are differently ordered. When both slices has zero length, true is returned.
-func AreEqualSortedSlicesFloat64(Slice1, Slice2 []float64, Epsilon float64) bool {
-	if len(Slice1) == 0 && len(Slice2) == 0 {
-		return true
-	}
-	if len(Slice1) != len(Slice2) {
-		return false
-	}
-	if !sort.Float64sAreSorted(Slice1) {
-		sort.Float64s(Slice1)
-	}
-	if !sort.Float64sAreSorted(Slice2) {
-		sort.Float64s(Slice2)
-	}
-	for i := range Slice1 {
-		if math.Abs(Slice1[i]-Slice2[i]) > Epsilon {
-			return false
-		}
-	}
-	return true
-}
-
-// AreEqualSlicesInt compares two int slices. The function ignores sorting, so compares both values and sorting of the slices.
-// If you want the function to sort the slices first, use AreEqualSortedSlicesInt instead.
-// When both slices has zero length, true is returned.
-func AreEqualSlicesInt(Slice1, Slice2 []int) bool {
-	if len(Slice1) == 0 && len(Slice2) == 0 {
-		return true
-	}
-	if len(Slice1) != len(Slice2) {
-		return false
-	}
-	for i := range Slice1 {
-		if Slice1[i] != Slice2[i] {
-			return false
-		}
-	}
-	return true
-}
-
-// AreEqualSortedSlicesInt compares two int slices.
-// The function takes into account sorting, meaning that if they have the same values, they are considered the same
-// even if they are differently ordered. When both slices has zero length, true is returned.
-func AreEqualSortedSlicesInt(Slice1, Slice2 []int) bool {
-	if len(Slice1) == 0 && len(Slice2) == 0 {
-		return true
-	}
-	if len(Slice1) != len(Slice2) {
-		return false
-	}
-	if !sort.IntsAreSorted(Slice1) {
-		sort.Ints(Slice1)
-	}
-	if !sort.IntsAreSorted(Slice2) {
-		sort.Ints(Slice2)
-	}
-	for i := range Slice1 {
-		if Slice1[i] != Slice2[i] {
-			return false
-		}
-	}
-	return true
-}
-
-// AreEqualSlicesString compares two string slices. The function ignores sorting, so compares both values and sorting of the slices.
-// If you want the function to sort the slices, use AreEqualSortedSlicesString instead.
-// When both slices has zero length, true is returned.
-func AreEqualSlicesString(Slice1, Slice2 []string) bool {
-	if len(Slice1) == 0 && len(Slice2) == 0 {
-		return true
-	}
-	if len(Slice1) != len(Slice2) {
-		return false
-	}
-	for i := range Slice1 {
-		if Slice1[i] != Slice2[i] {
-			return false
-		}
-	}
-	return true
-}
-
-// AreEqualSortedSlicesString compares two string slices.
-// The function takes into account sorting, meaning that if they have the same values, they are considered the same
-// even if they are differently ordered. When both slices has zero length, true is returned.
-func AreEqualSortedSlicesString(Slice1, Slice2 []string) bool {
-	if len(Slice1) == 0 && len(Slice2) == 0 {
-		return true
-	}
-	if len(Slice1) != len(Slice2) {
-		return false
-	}
-	if !sort.StringsAreSorted(Slice1) {
-		sort.Strings(Slice1)
-	}
-	if !sort.StringsAreSorted(Slice2) {
-		sort.Strings(Slice2)
-	}
-	for i := range Slice1 {
-		if Slice1[i] != Slice2[i] {
-			return false
-		}
-	}
-	return true
-}
+/* Package check offers functions to check various conditions, including
+
+* check if all boolean values are true (in a slice and in a map)
+* check if any boolean value is true (in a slice and in a map)
+* check which of boolean values is true (in a slice and in a map)
+* check if all elements of a slice have the same value
+* check if all keys of a map have the same value
+* check if two slices are the same (either taking into account the ordering of the slices or ignoring it)
+* check if two maps are the same
+* check if a value is in a slice
+* check if several values are in a slice
+* check if any of several values are in a slice
+* check which of several values are in a slice
+* check if a key-value pair is among a map's key-values
+* check if a value is among a map's values
+* check if several values from a slice are in a map
+* check if any value from a slice are in a map
+* check which values from a slice are in a map
+* check if all key-value pairs from a map are in a map
+* check if any of key-value pairs from a map are in a map
+* check which key-value pairs from a map are in a map
+
+In addition to these checks, the package enables one to create a unique slice (that is, with unique values) out of a slice.
+
+The package works with the following slices:
+
+* []string
+* []int
+* []float64
+* []bool
+
+and maps:
+
+* map[string]string
+* map[string]int
+* map[string]float64
+* map[string]bool
+* map[int]string
+* map[int]int
+* map[int]float64
+* map[int]bool
+
+Each check is available as a generic function working with any comparable type (like AllValuesIn, IsValueInMap or AreEqualMaps),
+and as functions for the above types (like AllValuesInIntSlice, IsValueInMapStringInt or AreEqualMapsStringString).
+Generic functions working with floats have the Float suffix (like IsValueInFloat) and work with any float type.
+
+Floats are compared using an epsilon value, meaning that two floats are considered equal when their absolute difference is less than or equal to epsilon.
+Functions with the Tol suffix (like IsValueInTol) take a Tolerance instead, which can be relative or combined absolute/relative,
+or can compare floats by their distance in ULPs.
+Since using floats as map keys is not recommended, the package does not with with such maps.
+
+Slices of indices returned by the Which functions (like WhichValuesIn) are sorted in increasing order,
+and so are slices of map keys returned by the type-specific functions (like IsValueInMapStringInt and WhichInMapInt)
+and by the generic functions with the Sorted suffix (like IsValueInMapSorted).
+Maps returned by the functions have no order, like all Go maps; use SortedKeys to range over them in a deterministic order.
+*/
+
+package check
+
+// AreEqualSlices compares two slices of any comparable type.
+// The function compares both values and ordering of the slices,
+// so if the slices have the same values but different orders, they are not considered the same.
+// When both slices has zero length, true is returned.
+func AreEqualSlices[T comparable](Slice1, Slice2 []T) bool {
+	if len(Slice1) == 0 && len(Slice2) == 0 {
+		return true
+	}
+	if len(Slice1) != len(Slice2) {
+		return false
+	}
+	for i := range Slice1 {
+		if Slice1[i] != Slice2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// AreEqualSlicesTol compares two slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The function compares both values and ordering of the slices.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesTol[T Float](Slice1, Slice2 []T, Tol Tolerance) bool {
+	if len(Slice1) == 0 && len(Slice2) == 0 {
+		return true
+	}
+	if len(Slice1) != len(Slice2) {
+		return false
+	}
+	for i := range Slice1 {
+		if !isWithinTolerance(Slice1[i], Slice2[i], Tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// AreEqualSlicesFloat compares two slices of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function compares both values and ordering of the slices.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesFloat[T Float](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AreEqualSlicesTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// AreEqualSortedSlices compares two slices of any comparable type.
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered. When both slices has zero length, true is returned.
+// The slices are compared as multisets, so each value needs to occur the same number of times in both slices.
+// The function counts the values in a map, so it runs in linear time and does not modify the slices.
+func AreEqualSortedSlices[T comparable](Slice1, Slice2 []T) bool {
+	if len(Slice1) == 0 && len(Slice2) == 0 {
+		return true
+	}
+	if len(Slice1) != len(Slice2) {
+		return false
+	}
+	counts := make(map[T]int, len(Slice1))
+	for _, value := range Slice1 {
+		counts[value]++
+	}
+	for _, value := range Slice2 {
+		if counts[value] == 0 {
+			return false
+		}
+		counts[value]--
+	}
+	return true
+}
+
+// AreEqualSortedSlicesTol compares two slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered. When both slices has zero length, true is returned.
+// The function sorts copies of the slices, so it does not modify them.
+func AreEqualSortedSlicesTol[T Float](Slice1, Slice2 []T, Tol Tolerance) bool {
+	if len(Slice1) == 0 && len(Slice2) == 0 {
+		return true
+	}
+	if len(Slice1) != len(Slice2) {
+		return false
+	}
+	return AreEqualSlicesTol(sortedFloats(Slice1), sortedFloats(Slice2), Tol)
+}
+
+// AreEqualSortedSlicesFloat compares two slices of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered. When both slices has zero length, true is returned.
+// The function does not modify the slices.
+func AreEqualSortedSlicesFloat[T Float](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AreEqualSortedSlicesTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// AreEqualSlicesFloat64 compares two float64 slices.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function ignores sorting, so compares both values and sorting of the slices.
+// Thus, if the slices have the same values but different orders, they are not considered the same.
+// When both slices has zero length, true is returned.
+// If you want the function to sort the slices first, use AreEqualSortedSlicesFloat64.
+func AreEqualSlicesFloat64(Slice1, Slice2 []float64, Epsilon float64) bool {
+	return AreEqualSlicesFloat(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSortedSlicesFloat64 compares two float64 slices.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered. When both slices has zero length, true is returned.
+// The slices are not modified.
+func AreEqualSortedSlicesFloat64(Slice1, Slice2 []float64, Epsilon float64) bool {
+	return AreEqualSortedSlicesFloat(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSlicesInt compares two int slices. The function ignores sorting, so compares both values and sorting of the slices.
+// If you want the function to sort the slices first, use AreEqualSortedSlicesInt instead.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesInt(Slice1, Slice2 []int) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesInt compares two int slices.
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered. When both slices has zero length, true is returned.
+// The slices are not modified.
+func AreEqualSortedSlicesInt(Slice1, Slice2 []int) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// AreEqualSlicesString compares two string slices. The function ignores sorting, so compares both values and sorting of the slices.
+// If you want the function to sort the slices, use AreEqualSortedSlicesString instead.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesString(Slice1, Slice2 []string) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesString compares two string slices.
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered. When both slices has zero length, true is returned.
+// The slices are not modified.
+func AreEqualSortedSlicesString(Slice1, Slice2 []string) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// AreEqualSlicesBool compares two bool slices. The function ignores sorting, so compares both values and sorting of the slices.
+// If you want the function to sort the slices first, use AreEqualSortedSlicesBool instead.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesBool(Slice1, Slice2 []bool) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesBool compares two bool slices.
+// The function takes into account sorting, meaning that if they have the same values, they are considered the same
+// even if they are differently ordered (so, if they have the same numbers of true and false values).
+// When both slices has zero length, true is returned.
+// The slices are not modified.
+func AreEqualSortedSlicesBool(Slice1, Slice2 []bool) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
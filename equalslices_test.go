@@ -272,3 +272,139 @@ func ExampleAreEqualSortedSlicesString() {
 	// true
 	// true
 }
+
+func TestAreEqualSlices(t *testing.T) {
+	type code string
+	tests := []struct {
+		slice1   []code
+		slice2   []code
+		expected bool
+	}{
+		{[]code{}, []code{}, true},
+		{[]code{"a"}, []code{}, false},
+		{[]code{"a", "b"}, []code{"a", "b"}, true},
+		{[]code{"a", "b"}, []code{"b", "a"}, false},
+	}
+	for _, test := range tests {
+		if AreEqualSlices(test.slice1, test.slice2) != test.expected {
+			t.Errorf("AreEqualSlices(%v, %v) should be %v", test.slice1, test.slice2, test.expected)
+		}
+	}
+}
+
+func TestAreEqualSortedSlices(t *testing.T) {
+	tests := []struct {
+		slice1   []int64
+		slice2   []int64
+		expected bool
+	}{
+		{[]int64{}, []int64{}, true},
+		{[]int64{1, 2, 2}, []int64{2, 1, 2}, true},
+		{[]int64{1, 2, 2}, []int64{2, 1, 1}, false},
+		{[]int64{1, 2}, []int64{2, 1, 1}, false},
+	}
+	for _, test := range tests {
+		if AreEqualSortedSlices(test.slice1, test.slice2) != test.expected {
+			t.Errorf("AreEqualSortedSlices(%v, %v) should be %v", test.slice1, test.slice2, test.expected)
+		}
+	}
+}
+
+func TestAreEqualSlicesFloat(t *testing.T) {
+	tests := []struct {
+		slice1   []float32
+		slice2   []float32
+		epsilon  float64
+		expected bool
+	}{
+		{[]float32{}, []float32{}, 0, true},
+		{[]float32{1, 2}, []float32{1, 2}, 0, true},
+		{[]float32{1, 2}, []float32{1, 2.5}, .1, false},
+		{[]float32{1, 2}, []float32{1, 2.5}, .5, true},
+		{[]float32{1, 2}, []float32{2, 1}, .5, false},
+	}
+	for _, test := range tests {
+		if AreEqualSlicesFloat(test.slice1, test.slice2, test.epsilon) != test.expected {
+			t.Errorf("AreEqualSlicesFloat(%v, %v, %v) should be %v", test.slice1, test.slice2, test.epsilon, test.expected)
+		}
+	}
+}
+
+func TestAreEqualSortedSlicesFloat(t *testing.T) {
+	tests := []struct {
+		slice1   []float32
+		slice2   []float32
+		epsilon  float64
+		expected bool
+	}{
+		{[]float32{}, []float32{}, 0, true},
+		{[]float32{1, 2}, []float32{2, 1}, 0, true},
+		{[]float32{1, 2}, []float32{2.5, 1}, .1, false},
+		{[]float32{1, 2}, []float32{2.5, 1}, .5, true},
+	}
+	for _, test := range tests {
+		if AreEqualSortedSlicesFloat(test.slice1, test.slice2, test.epsilon) != test.expected {
+			t.Errorf("AreEqualSortedSlicesFloat(%v, %v, %v) should be %v", test.slice1, test.slice2, test.epsilon, test.expected)
+		}
+	}
+}
+
+func ExampleAreEqualSlices() {
+	fmt.Println(AreEqualSlices([]bool{true, false}, []bool{true, false}))
+	fmt.Println(AreEqualSlices([]uint{1, 2}, []uint{2, 1}))
+	// Output:
+	// true
+	// false
+}
+
+func TestAreEqualSortedSlicesDoNotModifySlices(t *testing.T) {
+	ints1, ints2 := []int{3, 1, 2}, []int{2, 3, 1}
+	if !AreEqualSortedSlicesInt(ints1, ints2) || !AreEqualSlicesInt(ints1, []int{3, 1, 2}) || !AreEqualSlicesInt(ints2, []int{2, 3, 1}) {
+		t.Errorf("AreEqualSortedSlicesInt modified its arguments: %v, %v", ints1, ints2)
+	}
+	strings1, strings2 := []string{"c", "a", "b"}, []string{"b", "c", "a"}
+	if !AreEqualSortedSlicesString(strings1, strings2) || !AreEqualSlicesString(strings1, []string{"c", "a", "b"}) {
+		t.Errorf("AreEqualSortedSlicesString modified its arguments: %v, %v", strings1, strings2)
+	}
+	floats1, floats2 := []float64{3, 1, 2}, []float64{2.01, 3, 1}
+	if !AreEqualSortedSlicesFloat64(floats1, floats2, .1) ||
+		!AreEqualSlicesFloat64(floats1, []float64{3, 1, 2}, 0) || !AreEqualSlicesFloat64(floats2, []float64{2.01, 3, 1}, 0) {
+		t.Errorf("AreEqualSortedSlicesFloat64 modified its arguments: %v, %v", floats1, floats2)
+	}
+}
+
+func TestAreEqualSortedSlicesCountsValues(t *testing.T) {
+	tests := []struct {
+		slice1   []string
+		slice2   []string
+		expected bool
+	}{
+		{[]string{"a", "a", "b"}, []string{"a", "b", "b"}, false},
+		{[]string{"a", "a", "b"}, []string{"b", "a", "a"}, true},
+		{[]string{"a", "b", "c"}, []string{"a", "b", "d"}, false},
+	}
+	for _, test := range tests {
+		if AreEqualSortedSlices(test.slice1, test.slice2) != test.expected {
+			t.Errorf("AreEqualSortedSlices(%v, %v) should be %v", test.slice1, test.slice2, test.expected)
+		}
+	}
+}
+
+func benchmarkAreEqualSortedSlicesInt(n int, b *testing.B) {
+	slice1 := make([]int, n)
+	slice2 := make([]int, n)
+	for i := range slice1 {
+		slice1[i] = i
+		slice2[i] = n - i - 1
+	}
+	for i := 0; i < b.N; i++ {
+		AreEqualSortedSlicesInt(slice1, slice2)
+	}
+}
+
+func BenchmarkAreEqualSortedSlicesInt100(b *testing.B) {
+	benchmarkAreEqualSortedSlicesInt(100, b)
+}
+func BenchmarkAreEqualSortedSlicesInt10000(b *testing.B) {
+	benchmarkAreEqualSortedSlicesInt(10000, b)
+}
@@ -1,3 +1,3 @@
 module github.com/nyggus/check
 
-go 1.16
+go 1.20
@@ -0,0 +1,199 @@
+package check
+
+import (
+	"math"
+	"sort"
+)
+
+// Index is a membership index of a slice of any comparable type. It is built once, with NewIndex,
+// and then answers membership queries against the slice in constant time per looked-up value,
+// instead of scanning the slice each time, as IsValueIn, AllValuesIn and the like do.
+// This pays off when many slices are checked against the same slice.
+//
+// The index keeps its own copy of the data, so later changes to the slice are not reflected in it.
+type Index[T comparable] struct {
+	indices map[T][]int
+	length  int
+}
+
+// NewIndex builds an Index of a slice of any comparable type.
+func NewIndex[T comparable](Slice []T) *Index[T] {
+	indices := make(map[T][]int, len(Slice))
+	for i, value := range Slice {
+		indices[value] = append(indices[value], i)
+	}
+	return &Index[T]{indices: indices, length: len(Slice)}
+}
+
+// Len returns the length of the indexed slice.
+func (Idx *Index[T]) Len() int {
+	return Idx.length
+}
+
+// IndicesOf returns the indices of all occurrences of a value (X) in the indexed slice, in increasing order.
+// When the value is not in the slice, it returns an empty slice.
+func (Idx *Index[T]) IndicesOf(X T) []int {
+	indices := make([]int, len(Idx.indices[X]))
+	copy(indices, Idx.indices[X])
+	return indices
+}
+
+// IsValueIn checks if a value (X) is in the indexed slice; see IsValueIn.
+func (Idx *Index[T]) IsValueIn(X T) bool {
+	_, ok := Idx.indices[X]
+	return ok
+}
+
+// AllValuesIn checks if all values of a slice are in the indexed slice; see AllValuesIn.
+// When the slice is empty, it returns true. When the indexed slice is empty, it returns false.
+func (Idx *Index[T]) AllValuesIn(Slice []T) bool {
+	for _, x := range Slice {
+		if !Idx.IsValueIn(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyValueIn checks if any of the values of a slice is in the indexed slice; see AnyValueIn.
+// When the slice or the indexed slice (or both) is empty, it returns false.
+func (Idx *Index[T]) AnyValueIn(Slice []T) bool {
+	for _, x := range Slice {
+		if Idx.IsValueIn(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// WhichValuesIn checks which values of a slice are in the indexed slice; see WhichValuesIn.
+// When the slice or the indexed slice (or both) is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice as keys and their indices from the indexed slice as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func (Idx *Index[T]) WhichValuesIn(Slice []T) (map[T][]int, bool) {
+	values := make(map[T][]int)
+	for _, x := range Slice {
+		if _, ok := values[x]; ok {
+			continue
+		}
+		if Idx.IsValueIn(x) {
+			values[x] = Idx.IndicesOf(x)
+		}
+	}
+	return values, len(values) > 0
+}
+
+// FloatIndex is a membership index of a slice of any float type. It is built once, with NewFloatIndex,
+// and then answers membership queries against the slice in O(log n) time per looked-up value
+// (plus the number of matching elements, if their indices are returned), instead of scanning the slice each time.
+// Since floats are compared using the Epsilon parameter, the index cannot use a map; instead,
+// it keeps the slice's values sorted, with their original indices, and finds the values within Epsilon using binary search.
+//
+// The queries return the same results as the corresponding functions (like IsValueInFloat and WhichValuesInFloat).
+// The index keeps its own copy of the data, so later changes to the slice are not reflected in it.
+type FloatIndex[T Float] struct {
+	values  []T
+	indices []int
+	length  int
+}
+
+// Float64Index is a FloatIndex of a float64 slice.
+type Float64Index = FloatIndex[float64]
+
+// NewFloatIndex builds a FloatIndex of a slice of any float type.
+func NewFloatIndex[T Float](Slice []T) *FloatIndex[T] {
+	indices := make([]int, 0, len(Slice))
+	for i, value := range Slice {
+		// NaN and infinities are not equal to anything, so they are not indexed.
+		if !math.IsNaN(float64(value)) && !math.IsInf(float64(value), 0) {
+			indices = append(indices, i)
+		}
+	}
+	sort.SliceStable(indices, func(i, j int) bool { return Slice[indices[i]] < Slice[indices[j]] })
+	values := make([]T, len(indices))
+	for i, index := range indices {
+		values[i] = Slice[index]
+	}
+	return &FloatIndex[T]{values: values, indices: indices, length: len(Slice)}
+}
+
+// NewFloat64Index builds a Float64Index of a float64 slice.
+func NewFloat64Index(Slice []float64) *Float64Index {
+	return NewFloatIndex(Slice)
+}
+
+// Len returns the length of the indexed slice.
+func (Idx *FloatIndex[T]) Len() int {
+	return Idx.length
+}
+
+// within returns the range of the sorted values that are within Epsilon of X.
+func (Idx *FloatIndex[T]) within(X T, Epsilon float64) (int, int) {
+	tol := AbsTolerance(Epsilon)
+	from := sort.Search(len(Idx.values), func(k int) bool {
+		return Idx.values[k] >= X || isWithinTolerance(X, Idx.values[k], tol)
+	})
+	to := from + sort.Search(len(Idx.values)-from, func(k int) bool {
+		return !isWithinTolerance(X, Idx.values[from+k], tol)
+	})
+	return from, to
+}
+
+// IndicesOf returns the indices of all elements of the indexed slice that are within Epsilon of a value (X), in increasing order.
+// When there are no such elements, it returns an empty slice.
+func (Idx *FloatIndex[T]) IndicesOf(X T, Epsilon float64) []int {
+	from, to := Idx.within(X, Epsilon)
+	indices := make([]int, to-from)
+	copy(indices, Idx.indices[from:to])
+	sort.Ints(indices)
+	return indices
+}
+
+// IsValueIn checks if a float (X) is in the indexed slice; see IsValueInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func (Idx *FloatIndex[T]) IsValueIn(X T, Epsilon float64) bool {
+	from, to := Idx.within(X, Epsilon)
+	return from < to
+}
+
+// AllValuesIn checks if all values of a float slice are in the indexed slice; see AllValuesInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the slice is empty, it returns true. When the indexed slice is empty, it returns false.
+func (Idx *FloatIndex[T]) AllValuesIn(Slice []T, Epsilon float64) bool {
+	for _, x := range Slice {
+		if !Idx.IsValueIn(x, Epsilon) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyValueIn checks if any of the values of a float slice is in the indexed slice; see AnyValueInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the slice or the indexed slice (or both) is empty, it returns false.
+func (Idx *FloatIndex[T]) AnyValueIn(Slice []T, Epsilon float64) bool {
+	for _, x := range Slice {
+		if Idx.IsValueIn(x, Epsilon) {
+			return true
+		}
+	}
+	return false
+}
+
+// WhichValuesIn checks which values of a float slice are in the indexed slice; see WhichValuesInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the slice or the indexed slice (or both) is empty, it returns false and an empty map.
+// The function returns a tuple with a map with values from Slice as keys and their indices from the indexed slice as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func (Idx *FloatIndex[T]) WhichValuesIn(Slice []T, Epsilon float64) (map[T][]int, bool) {
+	values := make(map[T][]int)
+	if len(Slice) == 0 || Idx.length == 0 {
+		return values, false
+	}
+	for _, x := range UniqueSliceFloat(Slice, Epsilon) {
+		if indices := Idx.IndicesOf(x, Epsilon); len(indices) > 0 {
+			values[x] = indices
+		}
+	}
+	return values, len(values) > 0
+}
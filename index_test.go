@@ -0,0 +1,249 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	idx := NewIndex([]string{"a", "b", "a", "c"})
+	if idx.Len() != 4 {
+		t.Errorf("Len() = %v; want 4", idx.Len())
+	}
+	if !AreEqualSlicesInt(idx.IndicesOf("a"), []int{0, 2}) {
+		t.Errorf("IndicesOf(a) = %v; want [0 2]", idx.IndicesOf("a"))
+	}
+	if len(idx.IndicesOf("z")) != 0 {
+		t.Errorf("IndicesOf(z) = %v; want []", idx.IndicesOf("z"))
+	}
+	tests := []struct {
+		values []string
+		all    bool
+		any    bool
+		which  map[string][]int
+	}{
+		{[]string{}, true, false, map[string][]int{}},
+		{[]string{"a"}, true, true, map[string][]int{"a": {0, 2}}},
+		{[]string{"a", "c", "a"}, true, true, map[string][]int{"a": {0, 2}, "c": {3}}},
+		{[]string{"a", "z"}, false, true, map[string][]int{"a": {0, 2}}},
+		{[]string{"y", "z"}, false, false, map[string][]int{}},
+	}
+	for _, test := range tests {
+		if idx.AllValuesIn(test.values) != test.all {
+			t.Errorf("AllValuesIn(%v) should be %v", test.values, test.all)
+		}
+		if idx.AnyValueIn(test.values) != test.any {
+			t.Errorf("AnyValueIn(%v) should be %v", test.values, test.any)
+		}
+		which, ok := idx.WhichValuesIn(test.values)
+		if len(which) != len(test.which) || ok != (len(test.which) > 0) {
+			t.Errorf("WhichValuesIn(%v) = %v, %v; want %v", test.values, which, ok, test.which)
+		}
+		for key, indices := range test.which {
+			if !AreEqualSlicesInt(which[key], indices) {
+				t.Errorf("WhichValuesIn(%v) = %v; want %v", test.values, which, test.which)
+			}
+		}
+	}
+}
+
+func TestIndexIsNotAffectedByCallers(t *testing.T) {
+	slice := []int{1, 2, 1}
+	idx := NewIndex(slice)
+	slice[0] = 5
+	if idx.IsValueIn(5) || !idx.IsValueIn(1) {
+		t.Errorf("Index should not reflect changes to the indexed slice")
+	}
+	which, _ := idx.WhichValuesIn([]int{1})
+	which[1][0] = 100
+	if !AreEqualSlicesInt(idx.IndicesOf(1), []int{0, 2}) {
+		t.Errorf("Index should not be changed by changing WhichValuesIn's result")
+	}
+}
+
+func TestEmptyIndex(t *testing.T) {
+	idx := NewIndex([]int{})
+	if idx.IsValueIn(0) || idx.AnyValueIn([]int{0}) || idx.AllValuesIn([]int{0}) {
+		t.Errorf("an empty Index should not contain any value")
+	}
+	if !idx.AllValuesIn([]int{}) {
+		t.Errorf("AllValuesIn([]) should be true for an empty Index")
+	}
+	if which, ok := idx.WhichValuesIn([]int{0}); len(which) != 0 || ok {
+		t.Errorf("WhichValuesIn([0]) = %v, %v; want map[], false", which, ok)
+	}
+}
+
+func TestIndexMatchesScanningFunctions(t *testing.T) {
+	random := rand.New(rand.NewSource(9))
+	for round := 0; round < 200; round++ {
+		reference := make([]int, random.Intn(30))
+		for i := range reference {
+			reference[i] = random.Intn(20)
+		}
+		values := make([]int, random.Intn(10))
+		for i := range values {
+			values[i] = random.Intn(25)
+		}
+		idx := NewIndex(reference)
+		if idx.AllValuesIn(values) != AllValuesInIntSlice(values, reference) {
+			t.Fatalf("AllValuesIn(%v) differs for %v", values, reference)
+		}
+		if idx.AnyValueIn(values) != AnyValueInIntSlice(values, reference) {
+			t.Fatalf("AnyValueIn(%v) differs for %v", values, reference)
+		}
+		which, ok := idx.WhichValuesIn(values)
+		expected, expectedOk := WhichValuesInIntSlice(values, reference)
+		if ok != expectedOk || len(which) != len(expected) {
+			t.Fatalf("WhichValuesIn(%v) = %v; want %v", values, which, expected)
+		}
+		for key, indices := range expected {
+			if !AreEqualSlicesInt(which[key], indices) {
+				t.Fatalf("WhichValuesIn(%v) = %v; want %v", values, which, expected)
+			}
+		}
+	}
+}
+
+func ExampleNewIndex() {
+	idx := NewIndex([]string{"a", "b", "a", "c"})
+	fmt.Println(idx.IsValueIn("b"))
+	fmt.Println(idx.AllValuesIn([]string{"a", "c"}))
+	fmt.Println(idx.AnyValueIn([]string{"x", "y"}))
+	fmt.Println(idx.WhichValuesIn([]string{"a", "x"}))
+	// Output:
+	// true
+	// true
+	// false
+	// map[a:[0 2]] true
+}
+
+func benchmarkIndexAllValuesIn(n int, b *testing.B) {
+	reference := make([]string, n)
+	for i := range reference {
+		reference[i] = fmt.Sprint(i)
+	}
+	candidates := reference[n/2:]
+	idx := NewIndex(reference)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.AllValuesIn(candidates)
+	}
+}
+
+func BenchmarkIndexAllValuesIn100(b *testing.B) {
+	benchmarkIndexAllValuesIn(100, b)
+}
+func BenchmarkIndexAllValuesIn10000(b *testing.B) {
+	benchmarkIndexAllValuesIn(10000, b)
+}
+
+func TestFloat64Index(t *testing.T) {
+	idx := NewFloat64Index([]float64{.1, .3, .2, .11, math.NaN(), .3})
+	if idx.Len() != 6 {
+		t.Errorf("Len() = %v; want 6", idx.Len())
+	}
+	tests := []struct {
+		x        float64
+		epsilon  float64
+		expected []int
+	}{
+		{.1, 0, []int{0}},
+		{.1, .01, []int{0, 3}},
+		{.3, 0, []int{1, 5}},
+		{.25, .05, []int{1, 2, 5}},
+		{.4, .05, []int{}},
+		{math.NaN(), 1, []int{}},
+		{math.Inf(1), 1, []int{}},
+	}
+	for _, test := range tests {
+		actual := idx.IndicesOf(test.x, test.epsilon)
+		if !AreEqualSlicesInt(actual, test.expected) {
+			t.Errorf("IndicesOf(%v, %v) = %v; want %v", test.x, test.epsilon, actual, test.expected)
+		}
+		if idx.IsValueIn(test.x, test.epsilon) != (len(test.expected) > 0) {
+			t.Errorf("IsValueIn(%v, %v) should be %v", test.x, test.epsilon, len(test.expected) > 0)
+		}
+	}
+}
+
+func TestFloatIndexMatchesScanningFunctions(t *testing.T) {
+	random := rand.New(rand.NewSource(10))
+	special := []float64{math.NaN(), math.Inf(1), math.Inf(-1), 0, math.Copysign(0, -1)}
+	value := func() float64 {
+		if random.Intn(10) == 0 {
+			return special[random.Intn(len(special))]
+		}
+		return math.Round((random.Float64()*4-2)*10) / 10
+	}
+	for round := 0; round < 500; round++ {
+		reference := make([]float64, random.Intn(30))
+		for i := range reference {
+			reference[i] = value()
+		}
+		values := make([]float64, random.Intn(10))
+		for i := range values {
+			values[i] = value()
+		}
+		epsilon := []float64{0, .1, .25, 1}[random.Intn(4)]
+		idx := NewFloat64Index(reference)
+		if idx.AllValuesIn(values, epsilon) != AllValuesInFloat64Slice(values, reference, epsilon) {
+			t.Fatalf("AllValuesIn(%v, %v) differs for %v", values, epsilon, reference)
+		}
+		if idx.AnyValueIn(values, epsilon) != AnyValueInFloat64Slice(values, reference, epsilon) {
+			t.Fatalf("AnyValueIn(%v, %v) differs for %v", values, epsilon, reference)
+		}
+		which, ok := idx.WhichValuesIn(values, epsilon)
+		expected, expectedOk := WhichValuesInFloat64Slice(values, reference, epsilon)
+		if ok != expectedOk || len(which) != len(expected) {
+			t.Fatalf("WhichValuesIn(%v, %v) = %v; want %v", values, epsilon, which, expected)
+		}
+		for key, indices := range expected {
+			if !AreEqualSlicesInt(which[key], indices) {
+				t.Fatalf("WhichValuesIn(%v, %v) = %v; want %v", values, epsilon, which, expected)
+			}
+		}
+	}
+}
+
+func TestFloatIndexFloat32(t *testing.T) {
+	idx := NewFloatIndex([]float32{1, 2, 1.05})
+	if !AreEqualSlicesInt(idx.IndicesOf(1, .1), []int{0, 2}) {
+		t.Errorf("IndicesOf(1, .1) = %v; want [0 2]", idx.IndicesOf(1, .1))
+	}
+}
+
+func ExampleNewFloat64Index() {
+	idx := NewFloat64Index([]float64{.1, .3, .2, .11})
+	fmt.Println(idx.IsValueIn(.105, .01))
+	fmt.Println(idx.IndicesOf(.105, .01))
+	fmt.Println(idx.AllValuesIn([]float64{.1, .4}, .01))
+	fmt.Println(idx.WhichValuesIn([]float64{.3, .4}, .01))
+	// Output:
+	// true
+	// [0 3]
+	// false
+	// map[0.3:[1]] true
+}
+
+func benchmarkFloat64IndexAllValuesIn(n int, b *testing.B) {
+	reference := make([]float64, n)
+	for i := range reference {
+		reference[i] = float64(i) / 7
+	}
+	candidates := reference[n/2:]
+	idx := NewFloat64Index(reference)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.AllValuesIn(candidates, .001)
+	}
+}
+
+func BenchmarkFloat64IndexAllValuesIn100(b *testing.B) {
+	benchmarkFloat64IndexAllValuesIn(100, b)
+}
+func BenchmarkFloat64IndexAllValuesIn10000(b *testing.B) {
+	benchmarkFloat64IndexAllValuesIn(10000, b)
+}
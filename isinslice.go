@@ -1,9 +1,7 @@
 package check
 
-import "math"
-
-// IsValueInStringSlice checks if a string (X) is in a slice.
-func IsValueInStringSlice(X string, Slice []string) bool {
+// IsValueIn checks if a value (X) is in a slice. It works with slices of any comparable type.
+func IsValueIn[T comparable](X T, Slice []T) bool {
 	for _, value := range Slice {
 		if X == value {
 			return true
@@ -12,23 +10,40 @@ func IsValueInStringSlice(X string, Slice []string) bool {
 	return false
 }
 
-// IsValueInIntSlice checks if an int (X) is in a slice.
-func IsValueInIntSlice(X int, Slice []int) bool {
+// IsValueInTol checks if a float (X) is in a slice. It works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func IsValueInTol[T Float](X T, Slice []T, Tol Tolerance) bool {
 	for _, value := range Slice {
-		if X == value {
+		if isWithinTolerance(X, value, Tol) {
 			return true
 		}
 	}
 	return false
 }
 
+// IsValueInFloat checks if a float (X) is in a slice. It works with slices of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func IsValueInFloat[T Float](X T, Slice []T, Epsilon float64) bool {
+	return IsValueInTol(X, Slice, AbsTolerance(Epsilon))
+}
+
+// IsValueInStringSlice checks if a string (X) is in a slice.
+func IsValueInStringSlice(X string, Slice []string) bool {
+	return IsValueIn(X, Slice)
+}
+
+// IsValueInIntSlice checks if an int (X) is in a slice.
+func IsValueInIntSlice(X int, Slice []int) bool {
+	return IsValueIn(X, Slice)
+}
+
 // IsValueInFloat64Slice checks if a float64 (X) is in a slice.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 func IsValueInFloat64Slice(X float64, Slice []float64, Epsilon float64) bool {
-	for _, value := range Slice {
-		if math.Abs(X-value) <= Epsilon {
-			return true
-		}
-	}
-	return false
+	return IsValueInFloat(X, Slice, Epsilon)
+}
+
+// IsValueInBoolSlice checks if a bool (X) is in a slice.
+func IsValueInBoolSlice(X bool, Slice []bool) bool {
+	return IsValueIn(X, Slice)
 }
@@ -93,3 +93,54 @@ func ExampleIsValueInFloat64Slice() {
 	// false
 	// true
 }
+
+func TestIsValueIn(t *testing.T) {
+	type id int64
+	if !IsValueIn(id(3), []id{1, 2, 3}) {
+		t.Errorf("IsValueIn(3, [1 2 3]) should be true")
+	}
+	if IsValueIn(id(4), []id{1, 2, 3}) {
+		t.Errorf("IsValueIn(4, [1 2 3]) should be false")
+	}
+	if IsValueIn(id(4), []id{}) {
+		t.Errorf("IsValueIn(4, []) should be false")
+	}
+	if !IsValueIn(true, []bool{false, true}) {
+		t.Errorf("IsValueIn(true, [false true]) should be true")
+	}
+}
+
+func ExampleIsValueIn() {
+	fmt.Println(IsValueIn(uint32(10), []uint32{1, 10, 100}))
+	fmt.Println(IsValueIn("Alice", []string{"Bob", "Carol"}))
+	// Output:
+	// true
+	// false
+}
+
+func TestIsValueInFloat(t *testing.T) {
+	tests := []struct {
+		x        float32
+		slice    []float32
+		epsilon  float64
+		expected bool
+	}{
+		{.5, []float32{.5, 1}, 0, true},
+		{.5, []float32{.51, 1}, 0, false},
+		{.5, []float32{.51, 1}, .1, true},
+		{.5, []float32{}, 1, false},
+	}
+	for _, test := range tests {
+		if IsValueInFloat(test.x, test.slice, test.epsilon) != test.expected {
+			t.Errorf("IsValueInFloat(%v, %v, %v) should be %v", test.x, test.slice, test.epsilon, test.expected)
+		}
+	}
+}
+
+func ExampleIsValueInFloat() {
+	fmt.Println(IsValueInFloat(float32(.2), []float32{.1, .21}, .01))
+	fmt.Println(IsValueInFloat(float32(.2), []float32{.1, .21}, .001))
+	// Output:
+	// true
+	// false
+}
@@ -1,15 +1,14 @@
 package check
 
-import "math"
-
-// IsValueInMapStringString checks if a string (X) is among the map's values.
+// IsValueInMap checks if a value (X) is among the map's values. It works with maps of any comparable key and value types.
 // Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
-func IsValueInMapStringString(X string, Map map[string]string) ([]string, bool) {
+// The keys are in no particular order; use IsValueInMapSorted to get them sorted.
+func IsValueInMap[K comparable, V comparable](X V, Map map[K]V) ([]K, bool) {
 	var exists bool
-	keys := make([]string, 0)
+	keys := make([]K, 0)
 
 	if len(Map) == 0 {
-		return []string{}, false
+		return []K{}, false
 	}
 	for key, value := range Map {
 		if X == value {
@@ -20,17 +19,19 @@ func IsValueInMapStringString(X string, Map map[string]string) ([]string, bool)
 	return keys, exists
 }
 
-// IsValueInMapStringInt checks if an int (X) is among the map's values.
+// IsValueInMapTol checks if a float (X) is among the map's values. It works with maps of any comparable key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
 // Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
-func IsValueInMapStringInt(X int, Map map[string]int) ([]string, bool) {
+// The keys are in no particular order; use IsValueInMapSortedTol to get them sorted.
+func IsValueInMapTol[K comparable, V Float](X V, Map map[K]V, Tol Tolerance) ([]K, bool) {
 	var exists bool
-	keys := make([]string, 0)
+	keys := make([]K, 0)
 
 	if len(Map) == 0 {
-		return []string{}, false
+		return []K{}, false
 	}
 	for key, value := range Map {
-		if X == value {
+		if isWithinTolerance(X, value, Tol) {
 			exists = true
 			keys = append(keys, key)
 		}
@@ -38,76 +39,93 @@ func IsValueInMapStringInt(X int, Map map[string]int) ([]string, bool) {
 	return keys, exists
 }
 
+// IsValueInMapFloat checks if a float (X) is among the map's values. It works with maps of any comparable key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are in no particular order; use IsValueInMapSortedFloat to get them sorted.
+func IsValueInMapFloat[K comparable, V Float](X V, Map map[K]V, Epsilon float64) ([]K, bool) {
+	return IsValueInMapTol(X, Map, AbsTolerance(Epsilon))
+}
+
+// IsValueInMapSorted checks if a value (X) is among the map's values. It works with maps of any ordered key type and any comparable value type.
+// Returns a tuple of slice providing the map's keys that have this value, sorted in increasing order,
+// and a bool value (true if the returned slice is not empty).
+func IsValueInMapSorted[K Ordered, V comparable](X V, Map map[K]V) ([]K, bool) {
+	keys, exists := IsValueInMap(X, Map)
+	return sortKeys(keys), exists
+}
+
+// IsValueInMapSortedTol checks if a float (X) is among the map's values. It works with maps of any ordered key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Returns a tuple of slice providing the map's keys that have this value, sorted in increasing order,
+// and a bool value (true if the returned slice is not empty).
+func IsValueInMapSortedTol[K Ordered, V Float](X V, Map map[K]V, Tol Tolerance) ([]K, bool) {
+	keys, exists := IsValueInMapTol(X, Map, Tol)
+	return sortKeys(keys), exists
+}
+
+// IsValueInMapSortedFloat checks if a float (X) is among the map's values. It works with maps of any ordered key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, sorted in increasing order,
+// and a bool value (true if the returned slice is not empty).
+func IsValueInMapSortedFloat[K Ordered, V Float](X V, Map map[K]V, Epsilon float64) ([]K, bool) {
+	return IsValueInMapSortedTol(X, Map, AbsTolerance(Epsilon))
+}
+
+// IsValueInMapStringString checks if a string (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringString(X string, Map map[string]string) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// IsValueInMapStringInt checks if an int (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringInt(X int, Map map[string]int) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
 // IsValueInMapStringFloat64 checks if a foat64 (X) is among the map's values.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
 func IsValueInMapStringFloat64(X float64, Map map[string]float64, Epsilon float64) ([]string, bool) {
-	var exists bool
-	keys := make([]string, 0)
-
-	if len(Map) == 0 {
-		return []string{}, false
-	}
-	for key, value := range Map {
-		if math.Abs(X-value) <= Epsilon {
-			exists = true
-			keys = append(keys, key)
-		}
-	}
-	return keys, exists
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
 }
 
 // IsValueInMapIntString checks if an int (X) is among the map's values.
 // Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
 func IsValueInMapIntString(X string, Map map[int]string) ([]int, bool) {
-	var exists bool
-	keys := make([]int, 0)
-
-	if len(Map) == 0 {
-		return []int{}, false
-	}
-	for key, value := range Map {
-		if X == value {
-			exists = true
-			keys = append(keys, key)
-		}
-	}
-	return keys, exists
+	return IsValueInMapSorted(X, Map)
 }
 
 // IsValueInMapIntInt checks if an int (X) is among the map's values.
 // Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
 func IsValueInMapIntInt(X int, Map map[int]int) ([]int, bool) {
-	var exists bool
-	keys := make([]int, 0)
-
-	if len(Map) == 0 {
-		return []int{}, false
-	}
-	for key, value := range Map {
-		if X == value {
-			exists = true
-			keys = append(keys, key)
-		}
-	}
-	return keys, exists
+	return IsValueInMapSorted(X, Map)
 }
 
 // IsValueInMapIntFloat64 checks if a float64 (X) is among the map's values.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
 func IsValueInMapIntFloat64(X float64, Map map[int]float64, Epsilon float64) ([]int, bool) {
-	var exists bool
-	keys := make([]int, 0)
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
 
-	if len(Map) == 0 {
-		return []int{}, false
-	}
-	for key, value := range Map {
-		if math.Abs(X-value) <= Epsilon {
-			exists = true
-			keys = append(keys, key)
-		}
-	}
-	return keys, exists
+// IsValueInMapStringBool checks if a bool (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringBool(X bool, Map map[string]bool) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// IsValueInMapIntBool checks if a bool (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntBool(X bool, Map map[int]bool) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
 }
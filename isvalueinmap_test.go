@@ -194,3 +194,49 @@ func ExampleIsValueInMapIntFloat64() {
 	// false 0
 	// true 3
 }
+
+func TestIsValueInMap(t *testing.T) {
+	tests := []struct {
+		x            uint16
+		Map          map[int64]uint16
+		expectedBool bool
+		expectedKeys []int64
+	}{
+		{1, map[int64]uint16{}, false, []int64{}},
+		{1, map[int64]uint16{10: 1, 20: 2, 30: 1}, true, []int64{10, 30}},
+		{3, map[int64]uint16{10: 1, 20: 2, 30: 1}, false, []int64{}},
+	}
+	for _, test := range tests {
+		keys, ok := IsValueInMap(test.x, test.Map)
+		if ok != test.expectedBool || !AreEqualSortedSlices(keys, test.expectedKeys) {
+			t.Errorf("IsValueInMap(%v, %v) should be %v with keys %v", test.x, test.Map, test.expectedBool, test.expectedKeys)
+		}
+	}
+}
+
+func TestIsValueInMapFloat(t *testing.T) {
+	tests := []struct {
+		x            float32
+		Map          map[string]float32
+		epsilon      float64
+		expectedBool bool
+		expectedKeys []string
+	}{
+		{1, map[string]float32{}, 1, false, []string{}},
+		{1, map[string]float32{"a": 1, "b": 1.1, "c": 2}, 0, true, []string{"a"}},
+		{1, map[string]float32{"a": 1, "b": 1.1, "c": 2}, .2, true, []string{"a", "b"}},
+		{3, map[string]float32{"a": 1, "b": 1.1, "c": 2}, .2, false, []string{}},
+	}
+	for _, test := range tests {
+		keys, ok := IsValueInMapFloat(test.x, test.Map, test.epsilon)
+		if ok != test.expectedBool || !AreEqualSortedSlices(keys, test.expectedKeys) {
+			t.Errorf("IsValueInMapFloat(%v, %v, %v) should be %v with keys %v", test.x, test.Map, test.epsilon, test.expectedBool, test.expectedKeys)
+		}
+	}
+}
+
+func ExampleIsValueInMap() {
+	fmt.Println(IsValueInMap(true, map[string]bool{"debug": false, "verbose": true}))
+	// Output:
+	// [verbose] true
+}
@@ -0,0 +1,411 @@
+package check
+
+// AreEqualKeySets checks if two maps have the same keys, ignoring the values.
+// It works with maps of any comparable key type; the maps' value types can differ.
+func AreEqualKeySets[K comparable, V1 any, V2 any](Map1 map[K]V1, Map2 map[K]V2) bool {
+	if len(Map1) != len(Map2) {
+		return false
+	}
+	for key := range Map1 {
+		if _, ok := Map2[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// AllKeysIn checks if all keys of one map are keys of another map.
+// It works with maps of any comparable key type; the maps' value types can differ.
+// When any of the maps is empty, the function returns false.
+func AllKeysIn[K comparable, V1 any, V2 any](Map1 map[K]V1, Map2 map[K]V2) bool {
+	if len(Map1) == 0 || len(Map2) == 0 {
+		return false
+	}
+	for key := range Map1 {
+		if _, ok := Map2[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyKeyIn checks if any key of one map is a key of another map.
+// It works with maps of any comparable key type; the maps' value types can differ.
+// When any of the maps is empty, the function returns false.
+func AnyKeyIn[K comparable, V1 any, V2 any](Map1 map[K]V1, Map2 map[K]V2) bool {
+	for key := range Map1 {
+		if _, ok := Map2[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// WhichKeysIn checks which keys of one map are keys of another map.
+// It works with maps of any comparable key type; the maps' value types can differ.
+// Returns a tuple of a slice with the found keys, and true if the slice is not empty.
+// The keys are in no particular order; use WhichKeysInSorted to get them sorted.
+func WhichKeysIn[K comparable, V1 any, V2 any](Map1 map[K]V1, Map2 map[K]V2) ([]K, bool) {
+	keys := make([]K, 0)
+	for key := range Map1 {
+		if _, ok := Map2[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, len(keys) > 0
+}
+
+// WhichKeysInSorted checks which keys of one map are keys of another map.
+// It works with maps of any ordered key type; the maps' value types can differ.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInSorted[K Ordered, V1 any, V2 any](Map1 map[K]V1, Map2 map[K]V2) ([]K, bool) {
+	keys, exists := WhichKeysIn(Map1, Map2)
+	return sortKeys(keys), exists
+}
+
+// AreKeysEqualToSlice checks if a map's keys are the values of a slice. It works with maps of any comparable key type.
+// The slice is treated as a set: its ordering and duplicates are ignored.
+func AreKeysEqualToSlice[K comparable, V any](Map map[K]V, Keys []K) bool {
+	seen := make(map[K]struct{}, len(Keys))
+	for _, key := range Keys {
+		if _, ok := Map[key]; !ok {
+			return false
+		}
+		seen[key] = struct{}{}
+	}
+	return len(seen) == len(Map)
+}
+
+// DoKeysContainSlice checks if all values of a slice are keys of a map, for instance,
+// whether a configuration map has all the required keys. It works with maps of any comparable key type.
+// When the slice is empty, the function returns true, since no key is required.
+func DoKeysContainSlice[K comparable, V any](Map map[K]V, Keys []K) bool {
+	for _, key := range Keys {
+		if _, ok := Map[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// WhichKeysMissing checks which values of a slice are not keys of a map. It works with maps of any comparable key type.
+// Returns a tuple of a slice with the missing keys, in the order of their first occurrence in the slice,
+// and true if any key is missing.
+func WhichKeysMissing[K comparable, V any](Map map[K]V, Keys []K) ([]K, bool) {
+	missing := make([]K, 0)
+	seen := make(map[K]struct{})
+	for _, key := range Keys {
+		if _, ok := Map[key]; ok {
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			missing = append(missing, key)
+		}
+	}
+	return missing, len(missing) > 0
+}
+
+// AreEqualKeySetsMapStringString checks if two map[string]string maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapStringString(Map1, Map2 map[string]string) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapStringString checks if all keys of one map[string]string are keys of another map[string]string.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapStringString(Map1, Map2 map[string]string) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapStringString checks if any key of one map[string]string is a key of another map[string]string.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapStringString(Map1, Map2 map[string]string) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapStringString checks which keys of one map[string]string are keys of another map[string]string.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapStringString(Map1, Map2 map[string]string) ([]string, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapStringString checks if the keys of a map[string]string are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapStringString(Map map[string]string, Keys []string) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapStringString checks if all values of a slice are keys of a map[string]string; see DoKeysContainSlice.
+func DoKeysContainSliceMapStringString(Map map[string]string, Keys []string) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapStringString checks which values of a slice are not keys of a map[string]string; see WhichKeysMissing.
+func WhichKeysMissingMapStringString(Map map[string]string, Keys []string) ([]string, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapStringInt checks if two map[string]int maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapStringInt(Map1, Map2 map[string]int) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapStringInt checks if all keys of one map[string]int are keys of another map[string]int.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapStringInt(Map1, Map2 map[string]int) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapStringInt checks if any key of one map[string]int is a key of another map[string]int.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapStringInt(Map1, Map2 map[string]int) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapStringInt checks which keys of one map[string]int are keys of another map[string]int.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapStringInt(Map1, Map2 map[string]int) ([]string, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapStringInt checks if the keys of a map[string]int are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapStringInt(Map map[string]int, Keys []string) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapStringInt checks if all values of a slice are keys of a map[string]int; see DoKeysContainSlice.
+func DoKeysContainSliceMapStringInt(Map map[string]int, Keys []string) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapStringInt checks which values of a slice are not keys of a map[string]int; see WhichKeysMissing.
+func WhichKeysMissingMapStringInt(Map map[string]int, Keys []string) ([]string, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapStringFloat64 checks if two map[string]float64 maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapStringFloat64(Map1, Map2 map[string]float64) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapStringFloat64 checks if all keys of one map[string]float64 are keys of another map[string]float64.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapStringFloat64(Map1, Map2 map[string]float64) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapStringFloat64 checks if any key of one map[string]float64 is a key of another map[string]float64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapStringFloat64(Map1, Map2 map[string]float64) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapStringFloat64 checks which keys of one map[string]float64 are keys of another map[string]float64.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapStringFloat64(Map1, Map2 map[string]float64) ([]string, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapStringFloat64 checks if the keys of a map[string]float64 are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapStringFloat64(Map map[string]float64, Keys []string) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapStringFloat64 checks if all values of a slice are keys of a map[string]float64; see DoKeysContainSlice.
+func DoKeysContainSliceMapStringFloat64(Map map[string]float64, Keys []string) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapStringFloat64 checks which values of a slice are not keys of a map[string]float64; see WhichKeysMissing.
+func WhichKeysMissingMapStringFloat64(Map map[string]float64, Keys []string) ([]string, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapStringBool checks if two map[string]bool maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapStringBool(Map1, Map2 map[string]bool) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapStringBool checks if all keys of one map[string]bool are keys of another map[string]bool.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapStringBool(Map1, Map2 map[string]bool) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapStringBool checks if any key of one map[string]bool is a key of another map[string]bool.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapStringBool(Map1, Map2 map[string]bool) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapStringBool checks which keys of one map[string]bool are keys of another map[string]bool.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapStringBool(Map1, Map2 map[string]bool) ([]string, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapStringBool checks if the keys of a map[string]bool are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapStringBool(Map map[string]bool, Keys []string) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapStringBool checks if all values of a slice are keys of a map[string]bool; see DoKeysContainSlice.
+func DoKeysContainSliceMapStringBool(Map map[string]bool, Keys []string) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapStringBool checks which values of a slice are not keys of a map[string]bool; see WhichKeysMissing.
+func WhichKeysMissingMapStringBool(Map map[string]bool, Keys []string) ([]string, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapIntString checks if two map[int]string maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapIntString(Map1, Map2 map[int]string) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapIntString checks if all keys of one map[int]string are keys of another map[int]string.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapIntString(Map1, Map2 map[int]string) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapIntString checks if any key of one map[int]string is a key of another map[int]string.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapIntString(Map1, Map2 map[int]string) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapIntString checks which keys of one map[int]string are keys of another map[int]string.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapIntString(Map1, Map2 map[int]string) ([]int, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapIntString checks if the keys of a map[int]string are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapIntString(Map map[int]string, Keys []int) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapIntString checks if all values of a slice are keys of a map[int]string; see DoKeysContainSlice.
+func DoKeysContainSliceMapIntString(Map map[int]string, Keys []int) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapIntString checks which values of a slice are not keys of a map[int]string; see WhichKeysMissing.
+func WhichKeysMissingMapIntString(Map map[int]string, Keys []int) ([]int, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapIntInt checks if two map[int]int maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapIntInt(Map1, Map2 map[int]int) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapIntInt checks if all keys of one map[int]int are keys of another map[int]int.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapIntInt(Map1, Map2 map[int]int) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapIntInt checks if any key of one map[int]int is a key of another map[int]int.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapIntInt(Map1, Map2 map[int]int) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapIntInt checks which keys of one map[int]int are keys of another map[int]int.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapIntInt(Map1, Map2 map[int]int) ([]int, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapIntInt checks if the keys of a map[int]int are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapIntInt(Map map[int]int, Keys []int) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapIntInt checks if all values of a slice are keys of a map[int]int; see DoKeysContainSlice.
+func DoKeysContainSliceMapIntInt(Map map[int]int, Keys []int) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapIntInt checks which values of a slice are not keys of a map[int]int; see WhichKeysMissing.
+func WhichKeysMissingMapIntInt(Map map[int]int, Keys []int) ([]int, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapIntFloat64 checks if two map[int]float64 maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapIntFloat64(Map1, Map2 map[int]float64) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapIntFloat64 checks if all keys of one map[int]float64 are keys of another map[int]float64.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapIntFloat64(Map1, Map2 map[int]float64) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapIntFloat64 checks if any key of one map[int]float64 is a key of another map[int]float64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapIntFloat64(Map1, Map2 map[int]float64) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapIntFloat64 checks which keys of one map[int]float64 are keys of another map[int]float64.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapIntFloat64(Map1, Map2 map[int]float64) ([]int, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapIntFloat64 checks if the keys of a map[int]float64 are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapIntFloat64(Map map[int]float64, Keys []int) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapIntFloat64 checks if all values of a slice are keys of a map[int]float64; see DoKeysContainSlice.
+func DoKeysContainSliceMapIntFloat64(Map map[int]float64, Keys []int) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapIntFloat64 checks which values of a slice are not keys of a map[int]float64; see WhichKeysMissing.
+func WhichKeysMissingMapIntFloat64(Map map[int]float64, Keys []int) ([]int, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
+
+// AreEqualKeySetsMapIntBool checks if two map[int]bool maps have the same keys, ignoring the values.
+func AreEqualKeySetsMapIntBool(Map1, Map2 map[int]bool) bool {
+	return AreEqualKeySets(Map1, Map2)
+}
+
+// AllKeysInMapIntBool checks if all keys of one map[int]bool are keys of another map[int]bool.
+// When any of the maps is empty, the function returns false.
+func AllKeysInMapIntBool(Map1, Map2 map[int]bool) bool {
+	return AllKeysIn(Map1, Map2)
+}
+
+// AnyKeyInMapIntBool checks if any key of one map[int]bool is a key of another map[int]bool.
+// When any of the maps is empty, the function returns false.
+func AnyKeyInMapIntBool(Map1, Map2 map[int]bool) bool {
+	return AnyKeyIn(Map1, Map2)
+}
+
+// WhichKeysInMapIntBool checks which keys of one map[int]bool are keys of another map[int]bool.
+// Returns a tuple of a slice with the found keys, sorted in increasing order, and true if the slice is not empty.
+func WhichKeysInMapIntBool(Map1, Map2 map[int]bool) ([]int, bool) {
+	return WhichKeysInSorted(Map1, Map2)
+}
+
+// AreKeysEqualToSliceMapIntBool checks if the keys of a map[int]bool are the values of a slice; see AreKeysEqualToSlice.
+func AreKeysEqualToSliceMapIntBool(Map map[int]bool, Keys []int) bool {
+	return AreKeysEqualToSlice(Map, Keys)
+}
+
+// DoKeysContainSliceMapIntBool checks if all values of a slice are keys of a map[int]bool; see DoKeysContainSlice.
+func DoKeysContainSliceMapIntBool(Map map[int]bool, Keys []int) bool {
+	return DoKeysContainSlice(Map, Keys)
+}
+
+// WhichKeysMissingMapIntBool checks which values of a slice are not keys of a map[int]bool; see WhichKeysMissing.
+func WhichKeysMissingMapIntBool(Map map[int]bool, Keys []int) ([]int, bool) {
+	return WhichKeysMissing(Map, Keys)
+}
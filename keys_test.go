@@ -0,0 +1,109 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAreEqualKeySets(t *testing.T) {
+	tests := []struct {
+		Map1 map[string]int
+		Map2 map[string]bool
+		want bool
+	}{
+		{map[string]int{}, map[string]bool{}, true},
+		{map[string]int{"a": 1}, map[string]bool{}, false},
+		{map[string]int{"a": 1, "b": 2}, map[string]bool{"b": false, "a": true}, true},
+		{map[string]int{"a": 1, "b": 2}, map[string]bool{"a": true, "c": true}, false},
+		{map[string]int{"a": 1, "b": 2}, map[string]bool{"a": true, "b": true, "c": true}, false},
+	}
+	for _, test := range tests {
+		if got := AreEqualKeySets(test.Map1, test.Map2); got != test.want {
+			t.Errorf("AreEqualKeySets(%v, %v) = %v; want %v", test.Map1, test.Map2, got, test.want)
+		}
+	}
+	if !AreEqualKeySetsMapIntFloat64(map[int]float64{1: 1, 2: 2}, map[int]float64{2: 5, 1: 7}) {
+		t.Errorf("AreEqualKeySetsMapIntFloat64 should ignore the values")
+	}
+}
+
+func TestAllAnyWhichKeysIn(t *testing.T) {
+	tests := []struct {
+		Map1  map[int]string
+		Map2  map[int]string
+		all   bool
+		any   bool
+		which []int
+	}{
+		{map[int]string{}, map[int]string{}, false, false, []int{}},
+		{map[int]string{}, map[int]string{1: "a"}, false, false, []int{}},
+		{map[int]string{1: "a"}, map[int]string{}, false, false, []int{}},
+		{map[int]string{1: "a", 2: "b"}, map[int]string{1: "x", 2: "y", 3: "z"}, true, true, []int{1, 2}},
+		{map[int]string{3: "a", 1: "b", 5: "c"}, map[int]string{1: "x", 3: "y"}, false, true, []int{1, 3}},
+		{map[int]string{4: "a"}, map[int]string{1: "a"}, false, false, []int{}},
+	}
+	for _, test := range tests {
+		if got := AllKeysInMapIntString(test.Map1, test.Map2); got != test.all {
+			t.Errorf("AllKeysInMapIntString(%v, %v) = %v; want %v", test.Map1, test.Map2, got, test.all)
+		}
+		if got := AnyKeyInMapIntString(test.Map1, test.Map2); got != test.any {
+			t.Errorf("AnyKeyInMapIntString(%v, %v) = %v; want %v", test.Map1, test.Map2, got, test.any)
+		}
+		got, ok := WhichKeysInMapIntString(test.Map1, test.Map2)
+		if !AreEqualSlicesInt(got, test.which) || ok != (len(test.which) > 0) {
+			t.Errorf("WhichKeysInMapIntString(%v, %v) = %v, %v; want %v", test.Map1, test.Map2, got, ok, test.which)
+		}
+	}
+}
+
+func TestKeysAgainstSlice(t *testing.T) {
+	config := map[string]string{"host": "localhost", "port": "8080", "user": "admin"}
+	tests := []struct {
+		Keys    []string
+		equal   bool
+		contain bool
+		missing []string
+	}{
+		{[]string{}, false, true, []string{}},
+		{[]string{"port", "host"}, false, true, []string{}},
+		{[]string{"user", "port", "host"}, true, true, []string{}},
+		{[]string{"user", "port", "host", "port"}, true, true, []string{}},
+		{[]string{"user", "port", "host", "password"}, false, false, []string{"password"}},
+		{[]string{"timeout", "host", "password", "timeout"}, false, false, []string{"timeout", "password"}},
+	}
+	for _, test := range tests {
+		if got := AreKeysEqualToSliceMapStringString(config, test.Keys); got != test.equal {
+			t.Errorf("AreKeysEqualToSliceMapStringString(%v, %v) = %v; want %v", config, test.Keys, got, test.equal)
+		}
+		if got := DoKeysContainSliceMapStringString(config, test.Keys); got != test.contain {
+			t.Errorf("DoKeysContainSliceMapStringString(%v, %v) = %v; want %v", config, test.Keys, got, test.contain)
+		}
+		got, ok := WhichKeysMissingMapStringString(config, test.Keys)
+		if !AreEqualSlicesString(got, test.missing) || ok != (len(test.missing) > 0) {
+			t.Errorf("WhichKeysMissingMapStringString(%v, %v) = %v, %v; want %v", config, test.Keys, got, ok, test.missing)
+		}
+	}
+	if !AreKeysEqualToSlice(map[int]bool{}, []int{}) {
+		t.Errorf("AreKeysEqualToSlice of an empty map and an empty slice should be true")
+	}
+}
+
+func ExampleWhichKeysMissing() {
+	config := map[string]string{"host": "localhost", "port": "8080"}
+	required := []string{"host", "port", "user", "password"}
+	fmt.Println(DoKeysContainSlice(config, required))
+	fmt.Println(WhichKeysMissing(config, required))
+	// Output:
+	// false
+	// [user password] true
+}
+
+func ExampleAreEqualKeySets() {
+	counts := map[string]int{"a": 1, "b": 2}
+	flags := map[string]bool{"b": true, "a": false}
+	fmt.Println(AreEqualKeySets(counts, flags))
+	fmt.Println(WhichKeysInSorted(counts, map[string]float64{"b": 1.5, "c": 2}))
+	// Output:
+	// true
+	// [b] true
+}
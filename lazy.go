@@ -0,0 +1,116 @@
+package check
+
+import "errors"
+
+// Condition is a labelled condition that AnyLazy and AllLazy evaluate only when needed.
+// Create it with NewCondition (for a func() bool) or NewErrorCondition (for a func() error);
+// a Condition created otherwise (e.g., the zero Condition) has no check and is never met.
+type Condition struct {
+	Label string
+	check func() error
+}
+
+// errNotMet is returned by the check of a condition created with NewCondition when the condition is not met.
+var errNotMet = errors.New("condition not met")
+
+// errNoCheck is the error of a condition that has no check, like the zero Condition.
+var errNoCheck = errors.New("condition has no check")
+
+// NewCondition creates a labelled condition, which is met when Check returns true.
+// It panics if Check is nil.
+func NewCondition(Label string, Check func() bool) Condition {
+	if Check == nil {
+		panic("check: NewCondition(" + Label + ") got a nil Check")
+	}
+	return Condition{Label: Label, check: func() error {
+		if Check() {
+			return nil
+		}
+		return errNotMet
+	}}
+}
+
+// NewErrorCondition creates a labelled condition, which is met when Check returns nil.
+// The error returned by Check is reported in the Decision's Err field. It panics if Check is nil.
+func NewErrorCondition(Label string, Check func() error) Condition {
+	if Check == nil {
+		panic("check: NewErrorCondition(" + Label + ") got a nil Check")
+	}
+	return Condition{Label: Label, check: Check}
+}
+
+// Decision is the result of AnyLazy and AllLazy, telling which condition decided it.
+// Index and Label identify the deciding condition; when no condition was decisive
+// (when all conditions are met in AllLazy, or for no conditions), Index is -1 and Label is empty.
+// Err is the error returned by the deciding condition when it was created with NewErrorCondition and was not met.
+type Decision struct {
+	Result bool
+	Index  int
+	Label  string
+	Err    error
+}
+
+// String describes the decision, e.g., "failed: quota_exceeded" or "passed".
+func (d Decision) String() string {
+	s := "failed"
+	if d.Result {
+		s = "passed"
+	}
+	if d.Label != "" {
+		s += ": " + d.Label
+	}
+	if d.Err != nil {
+		s += ": " + d.Err.Error()
+	}
+	return s
+}
+
+// evaluate checks a condition, returning the error of an error condition that is not met.
+// A condition without a check is not met, with errNoCheck as its error.
+func (c Condition) evaluate() (bool, error) {
+	if c.check == nil {
+		return false, errNoCheck
+	}
+	err := c.check()
+	if err == nil {
+		return true, nil
+	}
+	if err == errNotMet {
+		return false, nil
+	}
+	return false, err
+}
+
+// AnyLazy checks if any of the conditions is met, evaluating them in order and stopping at the first one that is met,
+// which is then reported as the deciding condition. When no condition is met, the last condition is reported
+// as the deciding one, along with its error, so that the decision tells why it failed.
+// For no conditions, it returns false, like Any.
+func AnyLazy(Conditions ...Condition) Decision {
+	if len(Conditions) == 0 {
+		return Decision{Result: false, Index: -1}
+	}
+	var err error
+	for i, condition := range Conditions {
+		var met bool
+		if met, err = condition.evaluate(); met {
+			return Decision{Result: true, Index: i, Label: condition.Label}
+		}
+	}
+	last := len(Conditions) - 1
+	return Decision{Result: false, Index: last, Label: Conditions[last].Label, Err: err}
+}
+
+// AllLazy checks if all conditions are met, evaluating them in order and stopping at the first one that is not met,
+// which is then reported as the deciding condition, along with its error. When all conditions are met,
+// there is no deciding condition. For no conditions, it returns false, like All.
+func AllLazy(Conditions ...Condition) Decision {
+	if len(Conditions) == 0 {
+		return Decision{Result: false, Index: -1}
+	}
+	for i, condition := range Conditions {
+		if met, err := condition.evaluate(); !met {
+			return Decision{Result: false, Index: i, Label: condition.Label, Err: err}
+		}
+	}
+	return Decision{Result: true, Index: -1}
+}
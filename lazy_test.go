@@ -0,0 +1,130 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAllLazy(t *testing.T) {
+	var evaluated []string
+	condition := func(label string, met bool) Condition {
+		return NewCondition(label, func() bool {
+			evaluated = append(evaluated, label)
+			return met
+		})
+	}
+	errQuota := errors.New("quota exceeded")
+	tests := []struct {
+		Conditions []Condition
+		want       Decision
+		evaluated  []string
+	}{
+		{[]Condition{}, Decision{Result: false, Index: -1}, []string{}},
+		{[]Condition{condition("a", true), condition("b", true)}, Decision{Result: true, Index: -1}, []string{"a", "b"}},
+		{[]Condition{condition("a", true), condition("b", false), condition("c", false)}, Decision{Result: false, Index: 1, Label: "b"}, []string{"a", "b"}},
+		{
+			[]Condition{condition("a", true), NewErrorCondition("quota", func() error { evaluated = append(evaluated, "quota"); return errQuota }), condition("c", true)},
+			Decision{Result: false, Index: 1, Label: "quota", Err: errQuota},
+			[]string{"a", "quota"},
+		},
+		{[]Condition{NewErrorCondition("ok", func() error { evaluated = append(evaluated, "ok"); return nil })}, Decision{Result: true, Index: -1}, []string{"ok"}},
+	}
+	for _, test := range tests {
+		evaluated = []string{}
+		got := AllLazy(test.Conditions...)
+		if got != test.want || !AreEqualSlicesString(evaluated, test.evaluated) {
+			t.Errorf("AllLazy = %+v after evaluating %v; want %+v after evaluating %v", got, evaluated, test.want, test.evaluated)
+		}
+	}
+}
+
+func TestAnyLazy(t *testing.T) {
+	var evaluated []string
+	condition := func(label string, met bool) Condition {
+		return NewCondition(label, func() bool {
+			evaluated = append(evaluated, label)
+			return met
+		})
+	}
+	tests := []struct {
+		Conditions []Condition
+		want       Decision
+		evaluated  []string
+	}{
+		{[]Condition{}, Decision{Result: false, Index: -1}, []string{}},
+		{[]Condition{condition("a", false), condition("b", false)}, Decision{Result: false, Index: 1, Label: "b"}, []string{"a", "b"}},
+		{
+			[]Condition{condition("a", false), NewErrorCondition("quota", func() error { evaluated = append(evaluated, "quota"); return errQuota })},
+			Decision{Result: false, Index: 1, Label: "quota", Err: errQuota},
+			[]string{"a", "quota"},
+		},
+		{[]Condition{condition("a", false), condition("b", true), condition("c", true)}, Decision{Result: true, Index: 1, Label: "b"}, []string{"a", "b"}},
+		{
+			[]Condition{NewErrorCondition("err", func() error { evaluated = append(evaluated, "err"); return errors.New("no") }), condition("b", true)},
+			Decision{Result: true, Index: 1, Label: "b"},
+			[]string{"err", "b"},
+		},
+	}
+	for _, test := range tests {
+		evaluated = []string{}
+		got := AnyLazy(test.Conditions...)
+		if got != test.want || !AreEqualSlicesString(evaluated, test.evaluated) {
+			t.Errorf("AnyLazy = %+v after evaluating %v; want %+v after evaluating %v", got, evaluated, test.want, test.evaluated)
+		}
+	}
+}
+
+func TestConditionWithoutCheck(t *testing.T) {
+	if got := AllLazy(Condition{Label: "x"}); got.Result || got.Index != 0 || got.Err != errNoCheck {
+		t.Errorf("AllLazy(Condition{Label: x}) = %+v; want failed at 0 with errNoCheck", got)
+	}
+	if got := AnyLazy(Condition{}, NewCondition("b", func() bool { return true })); !got.Result || got.Index != 1 {
+		t.Errorf("AnyLazy(Condition{}, b) = %+v; want passed at 1", got)
+	}
+	for name, newCondition := range map[string]func(){
+		"NewCondition":      func() { NewCondition("x", nil) },
+		"NewErrorCondition": func() { NewErrorCondition("x", nil) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v(x, nil) should panic", name)
+				}
+			}()
+			newCondition()
+		}()
+	}
+}
+
+func TestDecisionString(t *testing.T) {
+	tests := []struct {
+		d    Decision
+		want string
+	}{
+		{Decision{Result: true, Index: -1}, "passed"},
+		{Decision{Result: false, Index: -1}, "failed"},
+		{Decision{Result: true, Index: 0, Label: "cache_hit"}, "passed: cache_hit"},
+		{Decision{Result: false, Index: 2, Label: "quota_exceeded"}, "failed: quota_exceeded"},
+		{Decision{Result: false, Index: 2, Label: "db", Err: errors.New("timeout")}, "failed: db: timeout"},
+	}
+	for _, test := range tests {
+		if got := test.d.String(); got != test.want {
+			t.Errorf("%+v.String() = %q; want %q", test.d, got, test.want)
+		}
+	}
+}
+
+func ExampleAllLazy() {
+	used, quota := 120, 100
+	decision := AllLazy(
+		NewCondition("authenticated", func() bool { return true }),
+		NewCondition("quota_exceeded", func() bool { return used <= quota }),
+		NewCondition("expensive_check", func() bool { panic("not evaluated") }),
+	)
+	fmt.Println(decision.Result, decision.Label)
+	fmt.Println(decision)
+	// Output:
+	// false quota_exceeded
+	// failed: quota_exceeded
+}
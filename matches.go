@@ -0,0 +1,337 @@
+package check
+
+import "sort"
+
+// Match is a value that was found, together with its positions:
+// indices when the value was looked up in a slice, and keys when it was looked up in a map's values.
+// The positions are sorted in increasing order.
+type Match[V Ordered, P Ordered] struct {
+	Value     V
+	Positions []P
+}
+
+// Matches is the result of looking up the values of a slice in another slice or in a map's values,
+// returned by the Match functions (like MatchValuesIn and MatchValuesInMap) instead of the tuples returned by the Which functions.
+// Each distinct looked-up value is either found, with its positions, or missing.
+// All methods return values sorted in increasing order (NaN first), so iterating over a Matches value is deterministic.
+type Matches[V Ordered, P Ordered] struct {
+	found   []Match[V, P]
+	missing []V
+}
+
+// newMatches looks up each distinct value of a slice using the positionsOf function,
+// which returns the value's positions, or no positions when the value is missing.
+func newMatches[V Ordered, P Ordered](Values []V, positionsOf func(X V) []P) *Matches[V, P] {
+	result := &Matches[V, P]{found: make([]Match[V, P], 0), missing: make([]V, 0)}
+	seen := make(map[V]struct{}, len(Values))
+	var nanSeen bool
+	for _, value := range Values {
+		// NaN is never equal to itself as a map key, so it is looked up only once.
+		if value != value {
+			if nanSeen {
+				continue
+			}
+			nanSeen = true
+		} else if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		if positions := positionsOf(value); len(positions) > 0 {
+			result.found = append(result.found, Match[V, P]{value, positions})
+		} else {
+			result.missing = append(result.missing, value)
+		}
+	}
+	sort.Slice(result.found, func(i, j int) bool { return less(result.found[i].Value, result.found[j].Value) })
+	sortKeys(result.missing)
+	return result
+}
+
+// Found returns the values that were found.
+func (M *Matches[V, P]) Found() []V {
+	values := make([]V, len(M.found))
+	for i, match := range M.found {
+		values[i] = match.Value
+	}
+	return values
+}
+
+// Missing returns the values that were not found.
+func (M *Matches[V, P]) Missing() []V {
+	values := make([]V, len(M.missing))
+	copy(values, M.missing)
+	return values
+}
+
+// IndicesOf returns the positions of a found value (X): indices in the searched slice or keys of the searched map.
+// When the value was not found (or was not looked up), it returns an empty slice.
+func (M *Matches[V, P]) IndicesOf(X V) []P {
+	i := sort.Search(len(M.found), func(i int) bool { return !less(M.found[i].Value, X) })
+	if i == len(M.found) || less(X, M.found[i].Value) {
+		return []P{}
+	}
+	positions := make([]P, len(M.found[i].Positions))
+	copy(positions, M.found[i].Positions)
+	return positions
+}
+
+// All returns the found values together with their positions.
+func (M *Matches[V, P]) All() []Match[V, P] {
+	matches := make([]Match[V, P], len(M.found))
+	for i, match := range M.found {
+		positions := make([]P, len(match.Positions))
+		copy(positions, match.Positions)
+		matches[i] = Match[V, P]{match.Value, positions}
+	}
+	return matches
+}
+
+// Count returns the number of values that were found.
+func (M *Matches[V, P]) Count() int {
+	return len(M.found)
+}
+
+// Len returns the number of distinct values that were looked up, found or not.
+func (M *Matches[V, P]) Len() int {
+	return len(M.found) + len(M.missing)
+}
+
+// AnyFound checks if any of the values was found.
+func (M *Matches[V, P]) AnyFound() bool {
+	return len(M.found) > 0
+}
+
+// AllFound checks if all of the values were found. When no values were looked up, it returns true.
+func (M *Matches[V, P]) AllFound() bool {
+	return len(M.missing) == 0
+}
+
+// MatchValuesIn looks up the values of one slice in another slice. It works with slices of any ordered type.
+// It is the counterpart of WhichValuesIn and WhichValuesNotIn, returning both the found and the missing values.
+func MatchValuesIn[T Ordered](Slice1, Slice2 []T) *Matches[T, int] {
+	return newMatches(Slice1, NewIndex(Slice2).IndicesOf)
+}
+
+// MatchValuesInTol looks up the values of one float slice in another slice. It works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// It is the counterpart of WhichValuesInTol and WhichValuesNotInTol; unlike WhichValuesInTol,
+// it looks up each distinct value of Slice1, even if it is within the tolerance of another one.
+func MatchValuesInTol[T Float](Slice1, Slice2 []T, Tol Tolerance) *Matches[T, int] {
+	return newMatches(Slice1, func(X T) []int {
+		indices := make([]int, 0)
+		for index, value := range Slice2 {
+			if isWithinTolerance(X, value, Tol) {
+				indices = append(indices, index)
+			}
+		}
+		return indices
+	})
+}
+
+// MatchValuesInFloat looks up the values of one float slice in another slice. It works with slices of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// It is the counterpart of WhichValuesInFloat and WhichValuesNotInFloat (see MatchValuesInTol).
+func MatchValuesInFloat[T Float](Slice1, Slice2 []T, Epsilon float64) *Matches[T, int] {
+	idx := NewFloatIndex(Slice2)
+	return newMatches(Slice1, func(X T) []int { return idx.IndicesOf(X, Epsilon) })
+}
+
+// MatchValuesInMap looks up the values of a slice in a map's values. It works with maps of any ordered key and value types.
+// The positions of the found values are the map's keys that have these values.
+// It is the counterpart of WhichValuesInMap and WhichValuesNotInMap, returning both the found and the missing values.
+func MatchValuesInMap[K Ordered, V Ordered](Slice []V, Map map[K]V) *Matches[V, K] {
+	keysOf := make(map[V][]K, len(Map))
+	for key, value := range Map {
+		keysOf[value] = append(keysOf[value], key)
+	}
+	return newMatches(Slice, func(X V) []K { return sortKeys(keysOf[X]) })
+}
+
+// MatchValuesInMapTol looks up the values of a float slice in a map's values.
+// It works with maps of any ordered key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The positions of the found values are the map's keys that have these values.
+func MatchValuesInMapTol[K Ordered, V Float](Slice []V, Map map[K]V, Tol Tolerance) *Matches[V, K] {
+	return newMatches(Slice, func(X V) []K {
+		keys, _ := IsValueInMapSortedTol(X, Map, Tol)
+		return keys
+	})
+}
+
+// MatchValuesInMapFloat looks up the values of a float slice in a map's values.
+// It works with maps of any ordered key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The positions of the found values are the map's keys that have these values.
+func MatchValuesInMapFloat[K Ordered, V Float](Slice []V, Map map[K]V, Epsilon float64) *Matches[V, K] {
+	return MatchValuesInMapTol(Slice, Map, AbsTolerance(Epsilon))
+}
+
+// PairMatches is the result of looking up the key-value pairs of a map in another map,
+// returned by the MatchKeyValuePairsInMap functions instead of the tuples returned by WhichKeyValuePairsInMap.
+// Each key of the first map is either found (the other map has the same value for it) or missing
+// (the other map does not have the key, or has a different value for it; see Mismatch).
+// All methods return keys sorted in increasing order.
+type PairMatches[K Ordered, V any] struct {
+	found      []K
+	missing    []K
+	mismatches map[K]PairMismatch[V]
+}
+
+// newPairMatches creates a PairMatches value from the keys of Map1 and the mismatching pairs.
+func newPairMatches[K Ordered, V any](Map1 map[K]V, Mismatches map[K]PairMismatch[V]) *PairMatches[K, V] {
+	result := &PairMatches[K, V]{found: make([]K, 0), missing: make([]K, 0), mismatches: Mismatches}
+	for key := range Map1 {
+		if _, ok := Mismatches[key]; ok {
+			result.missing = append(result.missing, key)
+		} else {
+			result.found = append(result.found, key)
+		}
+	}
+	sortKeys(result.found)
+	sortKeys(result.missing)
+	return result
+}
+
+// Found returns the keys whose key-value pairs were found.
+func (M *PairMatches[K, V]) Found() []K {
+	keys := make([]K, len(M.found))
+	copy(keys, M.found)
+	return keys
+}
+
+// Missing returns the keys whose key-value pairs were not found.
+func (M *PairMatches[K, V]) Missing() []K {
+	keys := make([]K, len(M.missing))
+	copy(keys, M.missing)
+	return keys
+}
+
+// Mismatch describes why the key-value pair of a key was not found.
+// The second returned value is false when the pair was found (or the key was not looked up).
+func (M *PairMatches[K, V]) Mismatch(Key K) (PairMismatch[V], bool) {
+	mismatch, ok := M.mismatches[Key]
+	return mismatch, ok
+}
+
+// Count returns the number of key-value pairs that were found.
+func (M *PairMatches[K, V]) Count() int {
+	return len(M.found)
+}
+
+// Len returns the number of key-value pairs that were looked up, found or not.
+func (M *PairMatches[K, V]) Len() int {
+	return len(M.found) + len(M.missing)
+}
+
+// AnyFound checks if any of the key-value pairs was found.
+func (M *PairMatches[K, V]) AnyFound() bool {
+	return len(M.found) > 0
+}
+
+// AllFound checks if all of the key-value pairs were found. When no pairs were looked up, it returns true.
+func (M *PairMatches[K, V]) AllFound() bool {
+	return len(M.missing) == 0
+}
+
+// MatchKeyValuePairsInMap looks up the key-value pairs of one map in another map.
+// It works with maps of any ordered key type and any comparable value type.
+// It is the counterpart of WhichKeyValuePairsInMap and WhichKeyValuePairsNotInMap.
+func MatchKeyValuePairsInMap[K Ordered, V comparable](Map1, Map2 map[K]V) *PairMatches[K, V] {
+	mismatches, _ := WhichKeyValuePairsNotInMap(Map1, Map2)
+	return newPairMatches(Map1, mismatches)
+}
+
+// MatchKeyValuePairsInMapTol looks up the key-value pairs of one map in another map.
+// It works with maps of any ordered key type and any float value type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func MatchKeyValuePairsInMapTol[K Ordered, V Float](Map1, Map2 map[K]V, Tol Tolerance) *PairMatches[K, V] {
+	mismatches, _ := WhichKeyValuePairsNotInMapTol(Map1, Map2, Tol)
+	return newPairMatches(Map1, mismatches)
+}
+
+// MatchKeyValuePairsInMapFloat looks up the key-value pairs of one map in another map.
+// It works with maps of any ordered key type and any float value type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func MatchKeyValuePairsInMapFloat[K Ordered, V Float](Map1, Map2 map[K]V, Epsilon float64) *PairMatches[K, V] {
+	return MatchKeyValuePairsInMapTol(Map1, Map2, AbsTolerance(Epsilon))
+}
+
+// MatchValuesInIntSlice looks up the values of one int slice in another slice; see MatchValuesIn.
+func MatchValuesInIntSlice(Slice1, Slice2 []int) *Matches[int, int] {
+	return MatchValuesIn(Slice1, Slice2)
+}
+
+// MatchValuesInStringSlice looks up the values of one string slice in another slice; see MatchValuesIn.
+func MatchValuesInStringSlice(Slice1, Slice2 []string) *Matches[string, int] {
+	return MatchValuesIn(Slice1, Slice2)
+}
+
+// MatchValuesInFloat64Slice looks up the values of one float64 slice in another slice; see MatchValuesInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func MatchValuesInFloat64Slice(Slice1, Slice2 []float64, Epsilon float64) *Matches[float64, int] {
+	return MatchValuesInFloat(Slice1, Slice2, Epsilon)
+}
+
+// MatchValuesInMapIntInt looks up the values of an int slice in the values of map[int]int; see MatchValuesInMap.
+func MatchValuesInMapIntInt(Slice []int, Map map[int]int) *Matches[int, int] {
+	return MatchValuesInMap(Slice, Map)
+}
+
+// MatchValuesInMapIntString looks up the values of a string slice in the values of map[int]string; see MatchValuesInMap.
+func MatchValuesInMapIntString(Slice []string, Map map[int]string) *Matches[string, int] {
+	return MatchValuesInMap(Slice, Map)
+}
+
+// MatchValuesInMapIntFloat64 looks up the values of a float64 slice in the values of map[int]float64; see MatchValuesInMapFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func MatchValuesInMapIntFloat64(Slice []float64, Map map[int]float64, Epsilon float64) *Matches[float64, int] {
+	return MatchValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// MatchValuesInMapStringInt looks up the values of an int slice in the values of map[string]int; see MatchValuesInMap.
+func MatchValuesInMapStringInt(Slice []int, Map map[string]int) *Matches[int, string] {
+	return MatchValuesInMap(Slice, Map)
+}
+
+// MatchValuesInMapStringString looks up the values of a string slice in the values of map[string]string; see MatchValuesInMap.
+func MatchValuesInMapStringString(Slice []string, Map map[string]string) *Matches[string, string] {
+	return MatchValuesInMap(Slice, Map)
+}
+
+// MatchValuesInMapStringFloat64 looks up the values of a float64 slice in the values of map[string]float64; see MatchValuesInMapFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func MatchValuesInMapStringFloat64(Slice []float64, Map map[string]float64, Epsilon float64) *Matches[float64, string] {
+	return MatchValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// MatchKeyValuePairsInMapIntInt looks up the key-value pairs of one map[int]int in another map[int]int; see MatchKeyValuePairsInMap.
+func MatchKeyValuePairsInMapIntInt(Map1, Map2 map[int]int) *PairMatches[int, int] {
+	return MatchKeyValuePairsInMap(Map1, Map2)
+}
+
+// MatchKeyValuePairsInMapIntString looks up the key-value pairs of one map[int]string in another map[int]string; see MatchKeyValuePairsInMap.
+func MatchKeyValuePairsInMapIntString(Map1, Map2 map[int]string) *PairMatches[int, string] {
+	return MatchKeyValuePairsInMap(Map1, Map2)
+}
+
+// MatchKeyValuePairsInMapIntFloat64 looks up the key-value pairs of one map[int]float64 in another map[int]float64; see MatchKeyValuePairsInMapFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func MatchKeyValuePairsInMapIntFloat64(Map1, Map2 map[int]float64, Epsilon float64) *PairMatches[int, float64] {
+	return MatchKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// MatchKeyValuePairsInMapStringInt looks up the key-value pairs of one map[string]int in another map[string]int; see MatchKeyValuePairsInMap.
+func MatchKeyValuePairsInMapStringInt(Map1, Map2 map[string]int) *PairMatches[string, int] {
+	return MatchKeyValuePairsInMap(Map1, Map2)
+}
+
+// MatchKeyValuePairsInMapStringString looks up the key-value pairs of one map[string]string in another map[string]string; see MatchKeyValuePairsInMap.
+func MatchKeyValuePairsInMapStringString(Map1, Map2 map[string]string) *PairMatches[string, string] {
+	return MatchKeyValuePairsInMap(Map1, Map2)
+}
+
+// MatchKeyValuePairsInMapStringFloat64 looks up the key-value pairs of one map[string]float64 in another map[string]float64; see MatchKeyValuePairsInMapFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func MatchKeyValuePairsInMapStringFloat64(Map1, Map2 map[string]float64, Epsilon float64) *PairMatches[string, float64] {
+	return MatchKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
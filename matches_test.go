@@ -0,0 +1,208 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestMatchValuesIn(t *testing.T) {
+	tests := []struct {
+		Slice1    []int
+		Slice2    []int
+		found     []int
+		missing   []int
+		anyFound  bool
+		allFound  bool
+		indicesOf map[int][]int
+	}{
+		{[]int{}, []int{1, 2}, []int{}, []int{}, false, true, map[int][]int{}},
+		{[]int{1, 2}, []int{}, []int{}, []int{1, 2}, false, false, map[int][]int{1: {}}},
+		{[]int{3, 1, 1}, []int{1, 2, 1, 3}, []int{1, 3}, []int{}, true, true, map[int][]int{1: {0, 2}, 3: {3}}},
+		{[]int{5, 2, 4, 2}, []int{1, 2, 1, 2}, []int{2}, []int{4, 5}, true, false, map[int][]int{2: {1, 3}, 4: {}}},
+	}
+	for _, test := range tests {
+		m := MatchValuesIn(test.Slice1, test.Slice2)
+		if !AreEqualSlicesInt(m.Found(), test.found) {
+			t.Errorf("MatchValuesIn(%v, %v).Found() = %v; want %v", test.Slice1, test.Slice2, m.Found(), test.found)
+		}
+		if !AreEqualSlicesInt(m.Missing(), test.missing) {
+			t.Errorf("MatchValuesIn(%v, %v).Missing() = %v; want %v", test.Slice1, test.Slice2, m.Missing(), test.missing)
+		}
+		if m.Count() != len(test.found) || m.Len() != len(test.found)+len(test.missing) {
+			t.Errorf("MatchValuesIn(%v, %v): Count() = %v, Len() = %v", test.Slice1, test.Slice2, m.Count(), m.Len())
+		}
+		if m.AnyFound() != test.anyFound || m.AllFound() != test.allFound {
+			t.Errorf("MatchValuesIn(%v, %v): AnyFound() = %v, AllFound() = %v; want %v, %v",
+				test.Slice1, test.Slice2, m.AnyFound(), m.AllFound(), test.anyFound, test.allFound)
+		}
+		for value, indices := range test.indicesOf {
+			if !AreEqualSlicesInt(m.IndicesOf(value), indices) {
+				t.Errorf("MatchValuesIn(%v, %v).IndicesOf(%v) = %v; want %v", test.Slice1, test.Slice2, value, m.IndicesOf(value), indices)
+			}
+		}
+	}
+}
+
+func TestMatchValuesInAgreesWithWhichValuesIn(t *testing.T) {
+	Slice1 := []string{"e", "a", "c", "a", "z"}
+	Slice2 := []string{"a", "b", "c", "a", "d", "c"}
+	m := MatchValuesIn(Slice1, Slice2)
+	found, _ := WhichValuesIn(Slice1, Slice2)
+	missing, _ := WhichValuesNotIn(Slice1, Slice2)
+	if m.Count() != len(found) || len(m.Missing()) != len(missing) {
+		t.Errorf("MatchValuesIn(%v, %v) = %v, %v; want %v, %v", Slice1, Slice2, m.Found(), m.Missing(), found, missing)
+	}
+	for _, match := range m.All() {
+		if !AreEqualSlicesInt(match.Positions, found[match.Value]) {
+			t.Errorf("MatchValuesIn(%v, %v): %v at %v; want %v", Slice1, Slice2, match.Value, match.Positions, found[match.Value])
+		}
+	}
+	for _, value := range m.Missing() {
+		if _, ok := missing[value]; !ok {
+			t.Errorf("MatchValuesIn(%v, %v): %v should not be missing", Slice1, Slice2, value)
+		}
+	}
+}
+
+func TestMatchesIsNotAffectedByCallers(t *testing.T) {
+	m := MatchValuesIn([]int{1, 3}, []int{1, 2, 1})
+	m.IndicesOf(1)[0] = 100
+	m.All()[0].Positions[0] = 100
+	m.Found()[0] = 100
+	m.Missing()[0] = 100
+	if !AreEqualSlicesInt(m.IndicesOf(1), []int{0, 2}) || !AreEqualSlicesInt(m.Found(), []int{1}) || !AreEqualSlicesInt(m.Missing(), []int{3}) {
+		t.Errorf("Matches should not reflect changes to the returned slices")
+	}
+}
+
+func TestMatchValuesInFloat(t *testing.T) {
+	nan := math.NaN()
+	Slice1 := []float64{2.005, nan, 1.01, nan, 7}
+	Slice2 := []float64{1, 2, 1.02, nan, 3}
+	for _, m := range []*Matches[float64, int]{
+		MatchValuesInFloat(Slice1, Slice2, .015),
+		MatchValuesInTol(Slice1, Slice2, AbsTolerance(.015)),
+		MatchValuesInFloat64Slice(Slice1, Slice2, .015),
+	} {
+		found := m.Found()
+		if len(found) != 2 || found[0] != 1.01 || found[1] != 2.005 {
+			t.Errorf("Found() = %v; want [1.01 2.005]", found)
+		}
+		missing := m.Missing()
+		if len(missing) != 2 || !math.IsNaN(missing[0]) || missing[1] != 7 {
+			t.Errorf("Missing() = %v; want [NaN 7]", missing)
+		}
+		if !AreEqualSlicesInt(m.IndicesOf(1.01), []int{0, 2}) || !AreEqualSlicesInt(m.IndicesOf(2.005), []int{1}) {
+			t.Errorf("IndicesOf(1.01), IndicesOf(2.005) = %v, %v; want [0 2], [1]", m.IndicesOf(1.01), m.IndicesOf(2.005))
+		}
+		if len(m.IndicesOf(nan)) != 0 || len(m.IndicesOf(1)) != 0 {
+			t.Errorf("IndicesOf(NaN), IndicesOf(1) = %v, %v; want [], []", m.IndicesOf(nan), m.IndicesOf(1))
+		}
+	}
+	m := MatchValuesInTol(Slice1, Slice2, AbsTolerance(.015).WithPolicy(FloatPolicy{NaNEqualsNaN: true}))
+	if !AreEqualSlicesInt(m.IndicesOf(nan), []int{3}) || m.Count() != 3 {
+		t.Errorf("IndicesOf(NaN) = %v; want [3]", m.IndicesOf(nan))
+	}
+}
+
+func TestMatchValuesInMap(t *testing.T) {
+	Map := map[string]int{"a": 1, "b": 2, "c": 1, "d": 4}
+	for _, m := range []*Matches[int, string]{
+		MatchValuesInMap([]int{1, 3, 4, 1}, Map),
+		MatchValuesInMapStringInt([]int{1, 3, 4, 1}, Map),
+	} {
+		if !AreEqualSlicesInt(m.Found(), []int{1, 4}) || !AreEqualSlicesInt(m.Missing(), []int{3}) {
+			t.Errorf("Found(), Missing() = %v, %v; want [1 4], [3]", m.Found(), m.Missing())
+		}
+		if !AreEqualSlicesString(m.IndicesOf(1), []string{"a", "c"}) || len(m.IndicesOf(3)) != 0 {
+			t.Errorf("IndicesOf(1), IndicesOf(3) = %v, %v; want [a c], []", m.IndicesOf(1), m.IndicesOf(3))
+		}
+	}
+	FloatMap := map[int]float64{3: 1.001, 1: 2, 2: .999}
+	for _, m := range []*Matches[float64, int]{
+		MatchValuesInMapFloat([]float64{1, 5}, FloatMap, .01),
+		MatchValuesInMapTol([]float64{1, 5}, FloatMap, AbsTolerance(.01)),
+		MatchValuesInMapIntFloat64([]float64{1, 5}, FloatMap, .01),
+	} {
+		if !AreEqualSlicesInt(m.IndicesOf(1), []int{2, 3}) || m.Count() != 1 || m.Len() != 2 {
+			t.Errorf("IndicesOf(1) = %v, Count() = %v, Len() = %v; want [2 3], 1, 2", m.IndicesOf(1), m.Count(), m.Len())
+		}
+	}
+}
+
+func TestMatchKeyValuePairsInMap(t *testing.T) {
+	Map1 := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	Map2 := map[string]int{"a": 1, "b": 5, "d": 4}
+	for _, m := range []*PairMatches[string, int]{
+		MatchKeyValuePairsInMap(Map1, Map2),
+		MatchKeyValuePairsInMapStringInt(Map1, Map2),
+	} {
+		if !AreEqualSlicesString(m.Found(), []string{"a", "d"}) || !AreEqualSlicesString(m.Missing(), []string{"b", "c"}) {
+			t.Errorf("Found(), Missing() = %v, %v; want [a d], [b c]", m.Found(), m.Missing())
+		}
+		if m.Count() != 2 || m.Len() != 4 || !m.AnyFound() || m.AllFound() {
+			t.Errorf("Count() = %v, Len() = %v, AnyFound() = %v, AllFound() = %v", m.Count(), m.Len(), m.AnyFound(), m.AllFound())
+		}
+		if mismatch, ok := m.Mismatch("b"); !ok || mismatch != (PairMismatch[int]{Value: 2, OtherValue: 5}) {
+			t.Errorf("Mismatch(b) = %v, %v; want {2 5 false}, true", mismatch, ok)
+		}
+		if mismatch, ok := m.Mismatch("c"); !ok || !mismatch.KeyAbsent {
+			t.Errorf("Mismatch(c) = %v, %v; want {3 0 true}, true", mismatch, ok)
+		}
+		if _, ok := m.Mismatch("a"); ok {
+			t.Errorf("Mismatch(a) should not be found")
+		}
+	}
+	FloatMap1 := map[int]float64{1: 1.001, 2: 2}
+	FloatMap2 := map[int]float64{1: 1, 2: 2.5}
+	for _, m := range []*PairMatches[int, float64]{
+		MatchKeyValuePairsInMapFloat(FloatMap1, FloatMap2, .01),
+		MatchKeyValuePairsInMapTol(FloatMap1, FloatMap2, AbsTolerance(.01)),
+		MatchKeyValuePairsInMapIntFloat64(FloatMap1, FloatMap2, .01),
+	} {
+		if !AreEqualSlicesInt(m.Found(), []int{1}) || !AreEqualSlicesInt(m.Missing(), []int{2}) {
+			t.Errorf("Found(), Missing() = %v, %v; want [1], [2]", m.Found(), m.Missing())
+		}
+	}
+	if m := MatchKeyValuePairsInMap(map[int]int{}, map[int]int{1: 1}); m.Len() != 0 || !m.AllFound() || m.AnyFound() {
+		t.Errorf("MatchKeyValuePairsInMap of an empty map should have no found and no missing pairs")
+	}
+}
+
+func ExampleMatchValuesIn() {
+	m := MatchValuesIn([]string{"c", "a", "x"}, []string{"a", "b", "a", "c"})
+	fmt.Println(m.Found(), m.Missing(), m.Count(), m.Len())
+	fmt.Println(m.IndicesOf("a"))
+	for _, match := range m.All() {
+		fmt.Println(match.Value, match.Positions)
+	}
+	// Output:
+	// [a c] [x] 2 3
+	// [0 2]
+	// a [0 2]
+	// c [3]
+}
+
+func ExampleMatchValuesInMap() {
+	m := MatchValuesInMap([]int{1, 7}, map[string]int{"b": 1, "a": 1, "c": 2})
+	fmt.Println(m.IndicesOf(1), m.Missing(), m.AllFound())
+	// Output:
+	// [a b] [7] false
+}
+
+func ExampleMatchKeyValuePairsInMap() {
+	m := MatchKeyValuePairsInMap(
+		map[string]int{"a": 1, "b": 2, "c": 3},
+		map[string]int{"a": 1, "b": 20},
+	)
+	fmt.Println(m.Found(), m.Missing())
+	mismatch, _ := m.Mismatch("b")
+	fmt.Println(mismatch.Value, mismatch.OtherValue, mismatch.KeyAbsent)
+	mismatch, _ = m.Mismatch("c")
+	fmt.Println(mismatch.KeyAbsent)
+	// Output:
+	// [a] [b c]
+	// 2 20 false
+	// true
+}
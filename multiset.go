@@ -0,0 +1,288 @@
+package check
+
+import (
+	"math"
+	"sort"
+)
+
+// Count describes how many times a value is required and found when a slice is checked as a multiset.
+// Required is the number of occurrences of the value in the first slice, and Found the total number of elements
+// of the second slice equal to the value (for floats, within the tolerance of it); Found is not capped at Required.
+// Shortfall is the number of occurrences that are missing from the second slice. For comparable types, it is
+// Required - Found, or 0 when Found is not smaller than Required. For floats, an element of the second slice
+// can make up for at most one occurrence, so Shortfall can be positive even when Found is not smaller than Required
+// (when the elements found are shared with other values).
+type Count struct {
+	Required  int
+	Found     int
+	Shortfall int
+}
+
+// AllValuesInWithCounts checks if all values of one slice are in another slice, taking into account how many times they occur.
+// It works with slices of any comparable type.
+// Unlike AllValuesIn, it requires each value to occur in the second slice at least as many times as it occurs in the first slice,
+// so it checks whether Slice1 is a sub-multiset of Slice2. For instance, []int{1, 1, 1} is not in []int{1},
+// but it is in []int{1, 1, 1, 2}.
+// When the first slice is empty, it returns true.
+func AllValuesInWithCounts[T comparable](Slice1, Slice2 []T) bool {
+	if len(Slice1) > len(Slice2) {
+		return false
+	}
+	counts := countValues(Slice2)
+	for _, value := range Slice1 {
+		if counts[value] == 0 {
+			return false
+		}
+		counts[value]--
+	}
+	return true
+}
+
+// AllValuesInWithCountsTol checks if all values of one float slice are in another slice, taking into account how many times they occur.
+// It works with slices of any float type. The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Each element of the second slice can match only one element of the first slice,
+// and the function checks whether all elements of the first slice can be matched at the same time.
+// When the first slice is empty, it returns true.
+func AllValuesInWithCountsTol[T Float](Slice1, Slice2 []T, Tol Tolerance) bool {
+	if len(Slice1) > len(Slice2) {
+		return false
+	}
+	for _, matched := range matchFloats(Slice1, Slice2, Tol) {
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// AllValuesInWithCountsFloat checks if all values of one float slice are in another slice, taking into account how many times they occur.
+// It works with slices of any float type. The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Each element of the second slice can match only one element of the first slice (see AllValuesInWithCountsTol).
+// When the first slice is empty, it returns true.
+func AllValuesInWithCountsFloat[T Float](Slice1, Slice2 []T, Epsilon float64) bool {
+	return AllValuesInWithCountsTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// WhichValuesInWithCounts checks how many times the values of one slice are in another slice. It works with slices of any comparable type.
+// The function returns a tuple with a map with values from Slice1 as keys and their counts (see Count) as the map's values,
+// and a boolean value (true if no value has a shortfall, that is, if AllValuesInWithCounts returns true).
+// When the first slice is empty, it returns an empty map and true.
+func WhichValuesInWithCounts[T comparable](Slice1, Slice2 []T) (map[T]Count, bool) {
+	counts := make(map[T]Count)
+	for _, value := range Slice1 {
+		count := counts[value]
+		count.Required++
+		counts[value] = count
+	}
+	for _, value := range Slice2 {
+		if count, ok := counts[value]; ok {
+			count.Found++
+			counts[value] = count
+		}
+	}
+	all := true
+	for value, count := range counts {
+		if count.Found < count.Required {
+			count.Shortfall = count.Required - count.Found
+			counts[value] = count
+			all = false
+		}
+	}
+	return counts, all
+}
+
+// WhichValuesInWithCountsTol checks how many times the values of one float slice are in another slice.
+// It works with slices of any float type. The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The function returns a tuple with a map with values from Slice1 as keys and their counts (see Count) as the map's values,
+// and a boolean value (true if no value has a shortfall, that is, if AllValuesInWithCountsTol returns true).
+// Found counts all elements of Slice2 within the tolerance of the value (see Count), but for Shortfall,
+// an element of Slice2 within the tolerance of several values makes up for at most one of them, as in AllValuesInWithCountsTol.
+// Note that a NaN key (possible with Tol.Policy.NaNEqualsNaN) can be reached only by ranging over the returned map,
+// and that -0 and +0 are the same map key.
+// When the first slice is empty, it returns an empty map and true.
+func WhichValuesInWithCountsTol[T Float](Slice1, Slice2 []T, Tol Tolerance) (map[T]Count, bool) {
+	counts := make(map[T]Count)
+	var nanCount Count
+	all := true
+	for i, matched := range matchFloats(Slice1, Slice2, Tol) {
+		value := Slice1[i]
+		isNaN := math.IsNaN(float64(value))
+		count := counts[value]
+		if isNaN {
+			count = nanCount
+		}
+		count.Required++
+		if !matched {
+			count.Shortfall++
+			all = false
+		}
+		if isNaN {
+			nanCount = count
+		} else {
+			counts[value] = count
+		}
+	}
+	for value, count := range counts {
+		count.Found = countWithinTolerance(value, Slice2, Tol)
+		counts[value] = count
+	}
+	if nanCount.Required > 0 {
+		nanCount.Found = countWithinTolerance(T(math.NaN()), Slice2, Tol)
+		counts[T(math.NaN())] = nanCount
+	}
+	return counts, all
+}
+
+// countWithinTolerance counts the elements of Slice that are within the tolerance of Value.
+func countWithinTolerance[T Float](Value T, Slice []T, Tol Tolerance) int {
+	count := 0
+	for _, other := range Slice {
+		if isWithinTolerance(Value, other, Tol) {
+			count++
+		}
+	}
+	return count
+}
+
+// WhichValuesInWithCountsFloat checks how many times the values of one float slice are in another slice.
+// It works with slices of any float type. The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice1 as keys and their counts (see Count) as the map's values,
+// and a boolean value (true if no value has a shortfall); see WhichValuesInWithCountsTol.
+// When the first slice is empty, it returns an empty map and true.
+func WhichValuesInWithCountsFloat[T Float](Slice1, Slice2 []T, Epsilon float64) (map[T]Count, bool) {
+	return WhichValuesInWithCountsTol(Slice1, Slice2, AbsTolerance(Epsilon))
+}
+
+// matchFloats matches the elements of Slice1 with the elements of Slice2 within the tolerance, using each element of Slice2 at most once,
+// so that as many elements of Slice1 as possible are matched. It returns whether each element of Slice1 is matched.
+//
+// NaN and infinities can only be equal to themselves, so they are matched by counting.
+// For the other values, the elements of Slice2 within the tolerance of a value form a contiguous range in sorted Slice2,
+// and the ranges of increasing values both start and end at increasing positions, as long as the relative tolerance is below 1.
+// Then, matching the sorted values of Slice1, one by one, with the first unused element of Slice2 within the tolerance is optimal.
+// For relative tolerances of 1 or more and with signed zeros, this does not hold, so augmenting paths are used instead.
+func matchFloats[T Float](Slice1, Slice2 []T, Tol Tolerance) []bool {
+	matched := make([]bool, len(Slice1))
+	special := make(map[float64][]int)
+	var finite1 []int
+	for i, value := range Slice1 {
+		if isSpecialFloat(value) {
+			special[specialFloatKey(value)] = append(special[specialFloatKey(value)], i)
+		} else {
+			finite1 = append(finite1, i)
+		}
+	}
+	var finite2 []int
+	for j, value := range Slice2 {
+		if !isSpecialFloat(value) {
+			finite2 = append(finite2, j)
+			continue
+		}
+		candidates := special[specialFloatKey(value)]
+		if len(candidates) > 0 && isWithinTolerance(Slice1[candidates[0]], value, Tol) {
+			matched[candidates[0]] = true
+			special[specialFloatKey(value)] = candidates[1:]
+		}
+	}
+
+	sort.SliceStable(finite1, func(a, b int) bool { return lessFloat(Slice1[finite1[a]], Slice1[finite1[b]]) })
+	sort.SliceStable(finite2, func(a, b int) bool { return lessFloat(Slice2[finite2[a]], Slice2[finite2[b]]) })
+	if Tol.Rel >= 1 || Tol.Policy.SignedZeros {
+		matchFloatsWithAugmentingPaths(Slice1, Slice2, finite1, finite2, Tol, matched)
+		return matched
+	}
+	j := 0
+	for _, i := range finite1 {
+		for j < len(finite2) && Slice2[finite2[j]] < Slice1[i] && !isWithinTolerance(Slice1[i], Slice2[finite2[j]], Tol) {
+			j++
+		}
+		if j < len(finite2) && isWithinTolerance(Slice1[i], Slice2[finite2[j]], Tol) {
+			matched[i] = true
+			j++
+		}
+	}
+	return matched
+}
+
+// matchFloatsWithAugmentingPaths finds a maximum matching of the elements of Slice1 and Slice2 at the indices First and Second,
+// and marks the matched elements of Slice1.
+func matchFloatsWithAugmentingPaths[T Float](Slice1, Slice2 []T, First, Second []int, Tol Tolerance, Matched []bool) {
+	matchOf := make(map[int]int, len(Second))
+	var augment func(i int, visited map[int]bool) bool
+	augment = func(i int, visited map[int]bool) bool {
+		for _, j := range Second {
+			if visited[j] || !isWithinTolerance(Slice1[i], Slice2[j], Tol) {
+				continue
+			}
+			visited[j] = true
+			if other, ok := matchOf[j]; !ok || augment(other, visited) {
+				matchOf[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for _, i := range First {
+		augment(i, make(map[int]bool))
+	}
+	for _, i := range matchOf {
+		Matched[i] = true
+	}
+}
+
+// isSpecialFloat checks if a float is NaN or an infinity.
+func isSpecialFloat[T Float](X T) bool {
+	return math.IsNaN(float64(X)) || math.IsInf(float64(X), 0)
+}
+
+// specialFloatKey returns a map key for NaN or an infinity (NaN cannot be a map key, as it is not equal to itself).
+func specialFloatKey[T Float](X T) float64 {
+	if math.IsNaN(float64(X)) {
+		return 0
+	}
+	return float64(X)
+}
+
+// AllValuesInIntSliceWithCounts checks if all values of one int slice are in another slice,
+// taking into account how many times they occur (see AllValuesInWithCounts).
+// When the first slice is empty, it returns true.
+func AllValuesInIntSliceWithCounts(Slice1, Slice2 []int) bool {
+	return AllValuesInWithCounts(Slice1, Slice2)
+}
+
+// AllValuesInStringSliceWithCounts checks if all values of one string slice are in another slice,
+// taking into account how many times they occur (see AllValuesInWithCounts).
+// When the first slice is empty, it returns true.
+func AllValuesInStringSliceWithCounts(Slice1, Slice2 []string) bool {
+	return AllValuesInWithCounts(Slice1, Slice2)
+}
+
+// AllValuesInFloat64SliceWithCounts checks if all values of one float64 slice are in another slice,
+// taking into account how many times they occur (see AllValuesInWithCountsTol).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first slice is empty, it returns true.
+func AllValuesInFloat64SliceWithCounts(Slice1, Slice2 []float64, Epsilon float64) bool {
+	return AllValuesInWithCountsFloat(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesInIntSliceWithCounts checks how many times the values of one int slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their counts (see Count) as the map's values,
+// and a boolean value (true if no value has a shortfall).
+func WhichValuesInIntSliceWithCounts(Slice1, Slice2 []int) (map[int]Count, bool) {
+	return WhichValuesInWithCounts(Slice1, Slice2)
+}
+
+// WhichValuesInStringSliceWithCounts checks how many times the values of one string slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their counts (see Count) as the map's values,
+// and a boolean value (true if no value has a shortfall).
+func WhichValuesInStringSliceWithCounts(Slice1, Slice2 []string) (map[string]Count, bool) {
+	return WhichValuesInWithCounts(Slice1, Slice2)
+}
+
+// WhichValuesInFloat64SliceWithCounts checks how many times the values of one float64 slice are in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice1 as keys and their counts (see Count) as the map's values,
+// and a boolean value (true if no value has a shortfall); see WhichValuesInWithCountsTol.
+func WhichValuesInFloat64SliceWithCounts(Slice1, Slice2 []float64, Epsilon float64) (map[float64]Count, bool) {
+	return WhichValuesInWithCountsFloat(Slice1, Slice2, Epsilon)
+}
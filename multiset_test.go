@@ -0,0 +1,194 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAllValuesInIntSliceWithCounts(t *testing.T) {
+	tests := []struct {
+		slice1   []int
+		slice2   []int
+		expected bool
+	}{
+		{[]int{}, []int{}, true},
+		{[]int{}, []int{1}, true},
+		{[]int{1}, []int{}, false},
+		{[]int{1, 1, 1}, []int{1}, false},
+		{[]int{1, 1, 1}, []int{1, 1, 1}, true},
+		{[]int{1, 1, 1}, []int{1, 2, 1, 3, 1}, true},
+		{[]int{1, 2, 1}, []int{1, 2, 2}, false},
+		{[]int{3, 2, 1}, []int{1, 2, 3}, true},
+	}
+	for _, test := range tests {
+		actual := AllValuesInIntSliceWithCounts(test.slice1, test.slice2)
+		if actual != test.expected {
+			t.Errorf("AllValuesInIntSliceWithCounts(%v, %v) = %v; want %v", test.slice1, test.slice2, actual, test.expected)
+		}
+	}
+}
+
+func ExampleAllValuesInIntSliceWithCounts() {
+	fmt.Println(AllValuesInIntSlice([]int{1, 1, 1}, []int{1}))
+	fmt.Println(AllValuesInIntSliceWithCounts([]int{1, 1, 1}, []int{1}))
+	fmt.Println(AllValuesInIntSliceWithCounts([]int{1, 1, 1}, []int{1, 2, 1, 1}))
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func TestAllValuesInStringSliceWithCounts(t *testing.T) {
+	tests := []struct {
+		slice1   []string
+		slice2   []string
+		expected bool
+	}{
+		{[]string{"a", "a"}, []string{"a", "b"}, false},
+		{[]string{"a", "a"}, []string{"a", "b", "a"}, true},
+		{[]string{"a", "c"}, []string{"a", "b", "a"}, false},
+	}
+	for _, test := range tests {
+		actual := AllValuesInStringSliceWithCounts(test.slice1, test.slice2)
+		if actual != test.expected {
+			t.Errorf("AllValuesInStringSliceWithCounts(%v, %v) = %v; want %v", test.slice1, test.slice2, actual, test.expected)
+		}
+	}
+}
+
+func TestAllValuesInFloat64SliceWithCounts(t *testing.T) {
+	tests := []struct {
+		slice1   []float64
+		slice2   []float64
+		epsilon  float64
+		expected bool
+	}{
+		{[]float64{}, []float64{}, 0, true},
+		{[]float64{.1, .1}, []float64{.1}, 0, false},
+		{[]float64{.1, .1}, []float64{.1, .1}, 0, true},
+		{[]float64{.1, .1}, []float64{.1, .105}, .01, true},
+		{[]float64{.1, .11}, []float64{.105, .2}, .01, false},
+		{[]float64{1, 1.5}, []float64{1.4, .9}, .5, true},
+		{[]float64{1.5, 1}, []float64{1.4, .5}, .5, true},
+		{[]float64{math.NaN()}, []float64{math.NaN()}, 0, false},
+		{[]float64{math.Inf(1)}, []float64{math.Inf(1)}, 0, false},
+	}
+	for _, test := range tests {
+		actual := AllValuesInFloat64SliceWithCounts(test.slice1, test.slice2, test.epsilon)
+		if actual != test.expected {
+			t.Errorf("AllValuesInFloat64SliceWithCounts(%v, %v, %v) = %v; want %v", test.slice1, test.slice2, test.epsilon, actual, test.expected)
+		}
+	}
+}
+
+func TestAllValuesInWithCountsTolSpecialValues(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	tol := AbsTolerance(0).WithPolicy(MissingDataPolicy)
+	if !AllValuesInWithCountsTol([]float64{nan, inf, 1}, []float64{1, inf, nan}, tol) {
+		t.Errorf("AllValuesInWithCountsTol([NaN +Inf 1], [1 +Inf NaN]) with MissingDataPolicy should be true")
+	}
+	if AllValuesInWithCountsTol([]float64{nan, nan}, []float64{nan, 1}, tol) {
+		t.Errorf("AllValuesInWithCountsTol([NaN NaN], [NaN 1]) with MissingDataPolicy should be false")
+	}
+	signed := AbsTolerance(.1).WithPolicy(FloatPolicy{SignedZeros: true})
+	negZero := math.Copysign(0, -1)
+	if !AllValuesInWithCountsTol([]float64{0, negZero}, []float64{negZero, .05}, signed) {
+		t.Errorf("AllValuesInWithCountsTol([0 -0], [-0 .05]) with signed zeros should be true")
+	}
+	if AllValuesInWithCountsTol([]float64{0, 0}, []float64{negZero, .05}, signed) {
+		t.Errorf("AllValuesInWithCountsTol([0 0], [-0 .05]) with signed zeros should be false")
+	}
+}
+
+func TestMatchFloatsIsMaximal(t *testing.T) {
+	random := rand.New(rand.NewSource(11))
+	tolerances := []Tolerance{AbsTolerance(0), AbsTolerance(.3), RelTolerance(.2), CombinedTolerance(.1, .5), ULPTolerance(1 << 51)}
+	value := func() float64 { return math.Round((random.Float64()*6-3)*10) / 10 }
+	for round := 0; round < 500; round++ {
+		slice1 := make([]float64, random.Intn(12))
+		for i := range slice1 {
+			slice1[i] = value()
+		}
+		slice2 := make([]float64, random.Intn(12))
+		for i := range slice2 {
+			slice2[i] = value()
+		}
+		for _, tol := range tolerances {
+			first, second := make([]int, len(slice1)), make([]int, len(slice2))
+			for i := range first {
+				first[i] = i
+			}
+			for j := range second {
+				second[j] = j
+			}
+			expected := make([]bool, len(slice1))
+			matchFloatsWithAugmentingPaths(slice1, slice2, first, second, tol, expected)
+			if countTrue(matchFloats(slice1, slice2, tol)) != countTrue(expected) {
+				t.Fatalf("matchFloats(%v, %v, %v) should match %v values", slice1, slice2, tol, countTrue(expected))
+			}
+		}
+	}
+}
+
+func TestWhichValuesInIntSliceWithCounts(t *testing.T) {
+	counts, ok := WhichValuesInIntSliceWithCounts([]int{1, 1, 1, 2, 3}, []int{1, 2, 2, 1})
+	expected := map[int]Count{
+		1: {Required: 3, Found: 2, Shortfall: 1},
+		2: {Required: 1, Found: 2, Shortfall: 0},
+		3: {Required: 1, Found: 0, Shortfall: 1},
+	}
+	if ok || len(counts) != len(expected) {
+		t.Errorf("WhichValuesInIntSliceWithCounts() = %v, %v; want %v, false", counts, ok, expected)
+	}
+	for value, count := range expected {
+		if counts[value] != count {
+			t.Errorf("WhichValuesInIntSliceWithCounts()[%v] = %+v; want %+v", value, counts[value], count)
+		}
+	}
+	if counts, ok := WhichValuesInIntSliceWithCounts([]int{}, []int{1}); len(counts) != 0 || !ok {
+		t.Errorf("WhichValuesInIntSliceWithCounts([], [1]) = %v, %v; want map[], true", counts, ok)
+	}
+}
+
+func TestWhichValuesInStringSliceWithCounts(t *testing.T) {
+	counts, ok := WhichValuesInStringSliceWithCounts([]string{"a", "a"}, []string{"a", "a", "a"})
+	if !ok || counts["a"] != (Count{Required: 2, Found: 3}) {
+		t.Errorf("WhichValuesInStringSliceWithCounts([a a], [a a a]) = %v, %v; want map[a:{2 3 0}], true", counts, ok)
+	}
+}
+
+func TestWhichValuesInFloat64SliceWithCounts(t *testing.T) {
+	counts, ok := WhichValuesInFloat64SliceWithCounts([]float64{1, 1, 1.05, 2}, []float64{1.01, 2, .99}, .1)
+	expected := map[float64]Count{
+		1:    {Required: 2, Found: 2, Shortfall: 0},
+		1.05: {Required: 1, Found: 2, Shortfall: 1},
+		2:    {Required: 1, Found: 1, Shortfall: 0},
+	}
+	if ok || len(counts) != len(expected) {
+		t.Errorf("WhichValuesInFloat64SliceWithCounts() = %v, %v; want %v, false", counts, ok, expected)
+	}
+	for value, count := range expected {
+		if counts[value] != count {
+			t.Errorf("WhichValuesInFloat64SliceWithCounts()[%v] = %+v; want %+v", value, counts[value], count)
+		}
+	}
+	nanCounts, _ := WhichValuesInWithCountsTol([]float64{math.NaN(), math.NaN()}, []float64{math.NaN()}, AbsTolerance(0).WithPolicy(MissingDataPolicy))
+	for value, count := range nanCounts {
+		if !math.IsNaN(value) || count != (Count{Required: 2, Found: 1, Shortfall: 1}) {
+			t.Errorf("WhichValuesInWithCountsTol([NaN NaN], [NaN]) = %v; want map[NaN:{2 1 1}]", nanCounts)
+		}
+	}
+}
+
+func ExampleWhichValuesInIntSliceWithCounts() {
+	counts, ok := WhichValuesInIntSliceWithCounts([]int{1, 1, 1, 2}, []int{1, 2, 1})
+	fmt.Printf("%+v\n", counts[1])
+	fmt.Printf("%+v\n", counts[2])
+	fmt.Println(ok)
+	// Output:
+	// {Required:3 Found:2 Shortfall:1}
+	// {Required:1 Found:1 Shortfall:0}
+	// false
+}
@@ -0,0 +1,3758 @@
+package check
+
+// This file contains the type-specific functions for the numeric types other than int and float64
+// (int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64 and float32), and for maps keyed by int64 and uint64.
+// They call the corresponding generic functions; float32 values are compared using the Epsilon parameter.
+
+// IsValueInInt8Slice checks if an int8 (X) is in a slice.
+func IsValueInInt8Slice(X int8, Slice []int8) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInInt8Slice checks if all values of one int8 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInInt8Slice(Slice1, Slice2 []int8) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInInt8Slice checks if any of the values of one int8 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInInt8Slice(Slice1, Slice2 []int8) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInInt8Slice checks which values of one int8 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInInt8Slice(Slice1, Slice2 []int8) (map[int8][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInInt8Slice checks which values of one int8 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInInt8Slice(Slice1, Slice2 []int8) (map[int8][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesInt8 compares two int8 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesInt8(Slice1, Slice2 []int8) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesInt8 compares two int8 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesInt8(Slice1, Slice2 []int8) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueInt8Slice checks if all elements of an int8 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueInt8Slice(Slice []int8) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueInt8Slice returns a slice with unique elements of an int8 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueInt8Slice(Slice []int8) []int8 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInInt16Slice checks if an int16 (X) is in a slice.
+func IsValueInInt16Slice(X int16, Slice []int16) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInInt16Slice checks if all values of one int16 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInInt16Slice(Slice1, Slice2 []int16) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInInt16Slice checks if any of the values of one int16 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInInt16Slice(Slice1, Slice2 []int16) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInInt16Slice checks which values of one int16 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInInt16Slice(Slice1, Slice2 []int16) (map[int16][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInInt16Slice checks which values of one int16 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInInt16Slice(Slice1, Slice2 []int16) (map[int16][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesInt16 compares two int16 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesInt16(Slice1, Slice2 []int16) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesInt16 compares two int16 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesInt16(Slice1, Slice2 []int16) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueInt16Slice checks if all elements of an int16 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueInt16Slice(Slice []int16) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueInt16Slice returns a slice with unique elements of an int16 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueInt16Slice(Slice []int16) []int16 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInInt32Slice checks if an int32 (X) is in a slice.
+func IsValueInInt32Slice(X int32, Slice []int32) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInInt32Slice checks if all values of one int32 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInInt32Slice(Slice1, Slice2 []int32) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInInt32Slice checks if any of the values of one int32 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInInt32Slice(Slice1, Slice2 []int32) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInInt32Slice checks which values of one int32 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInInt32Slice(Slice1, Slice2 []int32) (map[int32][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInInt32Slice checks which values of one int32 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInInt32Slice(Slice1, Slice2 []int32) (map[int32][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesInt32 compares two int32 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesInt32(Slice1, Slice2 []int32) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesInt32 compares two int32 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesInt32(Slice1, Slice2 []int32) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueInt32Slice checks if all elements of an int32 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueInt32Slice(Slice []int32) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueInt32Slice returns a slice with unique elements of an int32 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueInt32Slice(Slice []int32) []int32 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInInt64Slice checks if an int64 (X) is in a slice.
+func IsValueInInt64Slice(X int64, Slice []int64) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInInt64Slice checks if all values of one int64 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInInt64Slice(Slice1, Slice2 []int64) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInInt64Slice checks if any of the values of one int64 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInInt64Slice(Slice1, Slice2 []int64) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInInt64Slice checks which values of one int64 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInInt64Slice(Slice1, Slice2 []int64) (map[int64][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInInt64Slice checks which values of one int64 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInInt64Slice(Slice1, Slice2 []int64) (map[int64][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesInt64 compares two int64 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesInt64(Slice1, Slice2 []int64) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesInt64 compares two int64 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesInt64(Slice1, Slice2 []int64) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueInt64Slice checks if all elements of an int64 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueInt64Slice(Slice []int64) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueInt64Slice returns a slice with unique elements of an int64 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueInt64Slice(Slice []int64) []int64 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInUintSlice checks if an uint (X) is in a slice.
+func IsValueInUintSlice(X uint, Slice []uint) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInUintSlice checks if all values of one uint slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInUintSlice(Slice1, Slice2 []uint) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInUintSlice checks if any of the values of one uint slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInUintSlice(Slice1, Slice2 []uint) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInUintSlice checks which values of one uint slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInUintSlice(Slice1, Slice2 []uint) (map[uint][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInUintSlice checks which values of one uint slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInUintSlice(Slice1, Slice2 []uint) (map[uint][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesUint compares two uint slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesUint(Slice1, Slice2 []uint) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesUint compares two uint slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesUint(Slice1, Slice2 []uint) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueUintSlice checks if all elements of an uint slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueUintSlice(Slice []uint) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueUintSlice returns a slice with unique elements of an uint slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueUintSlice(Slice []uint) []uint {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInUint8Slice checks if an uint8 (X) is in a slice.
+func IsValueInUint8Slice(X uint8, Slice []uint8) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInUint8Slice checks if all values of one uint8 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInUint8Slice(Slice1, Slice2 []uint8) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInUint8Slice checks if any of the values of one uint8 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInUint8Slice(Slice1, Slice2 []uint8) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInUint8Slice checks which values of one uint8 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInUint8Slice(Slice1, Slice2 []uint8) (map[uint8][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInUint8Slice checks which values of one uint8 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInUint8Slice(Slice1, Slice2 []uint8) (map[uint8][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesUint8 compares two uint8 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesUint8(Slice1, Slice2 []uint8) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesUint8 compares two uint8 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesUint8(Slice1, Slice2 []uint8) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueUint8Slice checks if all elements of an uint8 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueUint8Slice(Slice []uint8) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueUint8Slice returns a slice with unique elements of an uint8 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueUint8Slice(Slice []uint8) []uint8 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInUint16Slice checks if an uint16 (X) is in a slice.
+func IsValueInUint16Slice(X uint16, Slice []uint16) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInUint16Slice checks if all values of one uint16 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInUint16Slice(Slice1, Slice2 []uint16) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInUint16Slice checks if any of the values of one uint16 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInUint16Slice(Slice1, Slice2 []uint16) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInUint16Slice checks which values of one uint16 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInUint16Slice(Slice1, Slice2 []uint16) (map[uint16][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInUint16Slice checks which values of one uint16 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInUint16Slice(Slice1, Slice2 []uint16) (map[uint16][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesUint16 compares two uint16 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesUint16(Slice1, Slice2 []uint16) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesUint16 compares two uint16 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesUint16(Slice1, Slice2 []uint16) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueUint16Slice checks if all elements of an uint16 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueUint16Slice(Slice []uint16) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueUint16Slice returns a slice with unique elements of an uint16 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueUint16Slice(Slice []uint16) []uint16 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInUint32Slice checks if an uint32 (X) is in a slice.
+func IsValueInUint32Slice(X uint32, Slice []uint32) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInUint32Slice checks if all values of one uint32 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInUint32Slice(Slice1, Slice2 []uint32) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInUint32Slice checks if any of the values of one uint32 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInUint32Slice(Slice1, Slice2 []uint32) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInUint32Slice checks which values of one uint32 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInUint32Slice(Slice1, Slice2 []uint32) (map[uint32][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInUint32Slice checks which values of one uint32 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInUint32Slice(Slice1, Slice2 []uint32) (map[uint32][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesUint32 compares two uint32 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesUint32(Slice1, Slice2 []uint32) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesUint32 compares two uint32 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesUint32(Slice1, Slice2 []uint32) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueUint32Slice checks if all elements of an uint32 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueUint32Slice(Slice []uint32) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueUint32Slice returns a slice with unique elements of an uint32 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueUint32Slice(Slice []uint32) []uint32 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInUint64Slice checks if an uint64 (X) is in a slice.
+func IsValueInUint64Slice(X uint64, Slice []uint64) bool {
+	return IsValueIn(X, Slice)
+}
+
+// AllValuesInUint64Slice checks if all values of one uint64 slice are in another slice.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInUint64Slice(Slice1, Slice2 []uint64) bool {
+	return AllValuesIn(Slice1, Slice2)
+}
+
+// AnyValueInUint64Slice checks if any of the values of one uint64 slice is in another slice.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInUint64Slice(Slice1, Slice2 []uint64) bool {
+	return AnyValueIn(Slice1, Slice2)
+}
+
+// WhichValuesInUint64Slice checks which values of one uint64 slice are in another slice.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInUint64Slice(Slice1, Slice2 []uint64) (map[uint64][]int, bool) {
+	return WhichValuesIn(Slice1, Slice2)
+}
+
+// WhichValuesNotInUint64Slice checks which values of one uint64 slice are not in another slice.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInUint64Slice(Slice1, Slice2 []uint64) (map[uint64][]int, bool) {
+	return WhichValuesNotIn(Slice1, Slice2)
+}
+
+// AreEqualSlicesUint64 compares two uint64 slices, taking into account both their values and their ordering.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesUint64(Slice1, Slice2 []uint64) bool {
+	return AreEqualSlices(Slice1, Slice2)
+}
+
+// AreEqualSortedSlicesUint64 compares two uint64 slices, ignoring their ordering.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesUint64(Slice1, Slice2 []uint64) bool {
+	return AreEqualSortedSlices(Slice1, Slice2)
+}
+
+// IsUniqueUint64Slice checks if all elements of an uint64 slice are unique.
+// If the slice has no elements, the function returns true.
+func IsUniqueUint64Slice(Slice []uint64) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// UniqueUint64Slice returns a slice with unique elements of an uint64 slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueUint64Slice(Slice []uint64) []uint64 {
+	return UniqueSlice(Slice)
+}
+
+// IsValueInFloat32Slice checks if a float32 (X) is in a slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func IsValueInFloat32Slice(X float32, Slice []float32, Epsilon float64) bool {
+	return IsValueInFloat(X, Slice, Epsilon)
+}
+
+// AllValuesInFloat32Slice checks if all values of one float32 slice are in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first slice is empty, it returns true. When the second slice is empty, it returns false.
+func AllValuesInFloat32Slice(Slice1, Slice2 []float32, Epsilon float64) bool {
+	return AllValuesInFloat(Slice1, Slice2, Epsilon)
+}
+
+// AnyValueInFloat32Slice checks if any of the values of one float32 slice is in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When the first or the second (or both) slice is empty, it returns false.
+func AnyValueInFloat32Slice(Slice1, Slice2 []float32, Epsilon float64) bool {
+	return AnyValueInFloat(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesInFloat32Slice checks which values of one float32 slice are in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice1 as keys and their indices from Slice2 as the map's values,
+// and a boolean value (true if the returned map is not empty).
+func WhichValuesInFloat32Slice(Slice1, Slice2 []float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesInFloat(Slice1, Slice2, Epsilon)
+}
+
+// WhichValuesNotInFloat32Slice checks which values of one float32 slice are not in another slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice1 as keys and their indices from Slice1 as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInFloat32Slice(Slice1, Slice2 []float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesNotInFloat(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSlicesFloat32 compares two float32 slices, taking into account both their values and their ordering.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When both slices has zero length, true is returned.
+func AreEqualSlicesFloat32(Slice1, Slice2 []float32, Epsilon float64) bool {
+	return AreEqualSlicesFloat(Slice1, Slice2, Epsilon)
+}
+
+// AreEqualSortedSlicesFloat32 compares two float32 slices, ignoring their ordering.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When both slices has zero length, true is returned. The slices are not modified.
+func AreEqualSortedSlicesFloat32(Slice1, Slice2 []float32, Epsilon float64) bool {
+	return AreEqualSortedSlicesFloat(Slice1, Slice2, Epsilon)
+}
+
+// IsUniqueFloat32Slice checks if all elements of a float32 slice are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// If the slice has no elements, the function returns true.
+func IsUniqueFloat32Slice(Slice []float32, Epsilon float64) bool {
+	return IsUniqueSliceFloat(Slice, Epsilon)
+}
+
+// UniqueFloat32Slice returns a slice with unique elements of a float32 slice.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueFloat32Slice(Slice []float32, Epsilon float64) []float32 {
+	return UniqueSliceFloat(Slice, Epsilon)
+}
+
+// AreEqualMapsStringInt8 compares two maps map[string]int8.
+func AreEqualMapsStringInt8(Map1, Map2 map[string]int8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringInt8 checks if an int8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringInt8(X int8, Map map[string]int8) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringInt8 checks if any of the values of an int8 slice is a value of map[string]int8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringInt8(Slice []int8, Map map[string]int8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringInt8 checks if all values of an int8 slice are values of map[string]int8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringInt8(Slice []int8, Map map[string]int8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringInt8 checks which values of an int8 slice are values of map[string]int8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringInt8(Slice []int8, Map map[string]int8) (map[int8][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringInt8 checks which values of an int8 slice are not values of map[string]int8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringInt8(Slice []int8, Map map[string]int8) (map[int8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringInt8 checks if all key-value pairs from one map[string]int8 are in another map[string]int8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringInt8(Map1, Map2 map[string]int8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringInt8 checks if any key-value pair from one map[string]int8 is in another map[string]int8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringInt8(Map1, Map2 map[string]int8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringInt8 checks which key-value pairs from one map[string]int8 are in another map[string]int8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringInt8(Map1, Map2 map[string]int8) (map[string]int8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringInt8 checks which key-value pairs from one map[string]int8 are not in another map[string]int8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringInt8(Map1, Map2 map[string]int8) (map[string]PairMismatch[int8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringInt8 checks if all values of map[string]int8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringInt8(Map map[string]int8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringInt16 compares two maps map[string]int16.
+func AreEqualMapsStringInt16(Map1, Map2 map[string]int16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringInt16 checks if an int16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringInt16(X int16, Map map[string]int16) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringInt16 checks if any of the values of an int16 slice is a value of map[string]int16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringInt16(Slice []int16, Map map[string]int16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringInt16 checks if all values of an int16 slice are values of map[string]int16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringInt16(Slice []int16, Map map[string]int16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringInt16 checks which values of an int16 slice are values of map[string]int16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringInt16(Slice []int16, Map map[string]int16) (map[int16][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringInt16 checks which values of an int16 slice are not values of map[string]int16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringInt16(Slice []int16, Map map[string]int16) (map[int16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringInt16 checks if all key-value pairs from one map[string]int16 are in another map[string]int16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringInt16(Map1, Map2 map[string]int16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringInt16 checks if any key-value pair from one map[string]int16 is in another map[string]int16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringInt16(Map1, Map2 map[string]int16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringInt16 checks which key-value pairs from one map[string]int16 are in another map[string]int16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringInt16(Map1, Map2 map[string]int16) (map[string]int16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringInt16 checks which key-value pairs from one map[string]int16 are not in another map[string]int16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringInt16(Map1, Map2 map[string]int16) (map[string]PairMismatch[int16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringInt16 checks if all values of map[string]int16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringInt16(Map map[string]int16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringInt32 compares two maps map[string]int32.
+func AreEqualMapsStringInt32(Map1, Map2 map[string]int32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringInt32 checks if an int32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringInt32(X int32, Map map[string]int32) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringInt32 checks if any of the values of an int32 slice is a value of map[string]int32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringInt32(Slice []int32, Map map[string]int32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringInt32 checks if all values of an int32 slice are values of map[string]int32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringInt32(Slice []int32, Map map[string]int32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringInt32 checks which values of an int32 slice are values of map[string]int32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringInt32(Slice []int32, Map map[string]int32) (map[int32][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringInt32 checks which values of an int32 slice are not values of map[string]int32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringInt32(Slice []int32, Map map[string]int32) (map[int32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringInt32 checks if all key-value pairs from one map[string]int32 are in another map[string]int32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringInt32(Map1, Map2 map[string]int32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringInt32 checks if any key-value pair from one map[string]int32 is in another map[string]int32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringInt32(Map1, Map2 map[string]int32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringInt32 checks which key-value pairs from one map[string]int32 are in another map[string]int32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringInt32(Map1, Map2 map[string]int32) (map[string]int32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringInt32 checks which key-value pairs from one map[string]int32 are not in another map[string]int32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringInt32(Map1, Map2 map[string]int32) (map[string]PairMismatch[int32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringInt32 checks if all values of map[string]int32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringInt32(Map map[string]int32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringInt64 compares two maps map[string]int64.
+func AreEqualMapsStringInt64(Map1, Map2 map[string]int64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringInt64 checks if an int64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringInt64(X int64, Map map[string]int64) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringInt64 checks if any of the values of an int64 slice is a value of map[string]int64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringInt64(Slice []int64, Map map[string]int64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringInt64 checks if all values of an int64 slice are values of map[string]int64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringInt64(Slice []int64, Map map[string]int64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringInt64 checks which values of an int64 slice are values of map[string]int64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringInt64(Slice []int64, Map map[string]int64) (map[int64][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringInt64 checks which values of an int64 slice are not values of map[string]int64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringInt64(Slice []int64, Map map[string]int64) (map[int64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringInt64 checks if all key-value pairs from one map[string]int64 are in another map[string]int64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringInt64(Map1, Map2 map[string]int64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringInt64 checks if any key-value pair from one map[string]int64 is in another map[string]int64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringInt64(Map1, Map2 map[string]int64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringInt64 checks which key-value pairs from one map[string]int64 are in another map[string]int64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringInt64(Map1, Map2 map[string]int64) (map[string]int64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringInt64 checks which key-value pairs from one map[string]int64 are not in another map[string]int64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringInt64(Map1, Map2 map[string]int64) (map[string]PairMismatch[int64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringInt64 checks if all values of map[string]int64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringInt64(Map map[string]int64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringUint compares two maps map[string]uint.
+func AreEqualMapsStringUint(Map1, Map2 map[string]uint) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringUint checks if an uint (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringUint(X uint, Map map[string]uint) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringUint checks if any of the values of an uint slice is a value of map[string]uint.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringUint(Slice []uint, Map map[string]uint) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringUint checks if all values of an uint slice are values of map[string]uint.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringUint(Slice []uint, Map map[string]uint) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringUint checks which values of an uint slice are values of map[string]uint.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringUint(Slice []uint, Map map[string]uint) (map[uint][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringUint checks which values of an uint slice are not values of map[string]uint.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringUint(Slice []uint, Map map[string]uint) (map[uint][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringUint checks if all key-value pairs from one map[string]uint are in another map[string]uint.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringUint(Map1, Map2 map[string]uint) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringUint checks if any key-value pair from one map[string]uint is in another map[string]uint.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringUint(Map1, Map2 map[string]uint) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringUint checks which key-value pairs from one map[string]uint are in another map[string]uint.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringUint(Map1, Map2 map[string]uint) (map[string]uint, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringUint checks which key-value pairs from one map[string]uint are not in another map[string]uint.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringUint(Map1, Map2 map[string]uint) (map[string]PairMismatch[uint], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringUint checks if all values of map[string]uint are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringUint(Map map[string]uint) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringUint8 compares two maps map[string]uint8.
+func AreEqualMapsStringUint8(Map1, Map2 map[string]uint8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringUint8 checks if an uint8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringUint8(X uint8, Map map[string]uint8) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringUint8 checks if any of the values of an uint8 slice is a value of map[string]uint8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringUint8(Slice []uint8, Map map[string]uint8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringUint8 checks if all values of an uint8 slice are values of map[string]uint8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringUint8(Slice []uint8, Map map[string]uint8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringUint8 checks which values of an uint8 slice are values of map[string]uint8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringUint8(Slice []uint8, Map map[string]uint8) (map[uint8][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringUint8 checks which values of an uint8 slice are not values of map[string]uint8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringUint8(Slice []uint8, Map map[string]uint8) (map[uint8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringUint8 checks if all key-value pairs from one map[string]uint8 are in another map[string]uint8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringUint8(Map1, Map2 map[string]uint8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringUint8 checks if any key-value pair from one map[string]uint8 is in another map[string]uint8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringUint8(Map1, Map2 map[string]uint8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringUint8 checks which key-value pairs from one map[string]uint8 are in another map[string]uint8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringUint8(Map1, Map2 map[string]uint8) (map[string]uint8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringUint8 checks which key-value pairs from one map[string]uint8 are not in another map[string]uint8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringUint8(Map1, Map2 map[string]uint8) (map[string]PairMismatch[uint8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringUint8 checks if all values of map[string]uint8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringUint8(Map map[string]uint8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringUint16 compares two maps map[string]uint16.
+func AreEqualMapsStringUint16(Map1, Map2 map[string]uint16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringUint16 checks if an uint16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringUint16(X uint16, Map map[string]uint16) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringUint16 checks if any of the values of an uint16 slice is a value of map[string]uint16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringUint16(Slice []uint16, Map map[string]uint16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringUint16 checks if all values of an uint16 slice are values of map[string]uint16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringUint16(Slice []uint16, Map map[string]uint16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringUint16 checks which values of an uint16 slice are values of map[string]uint16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringUint16(Slice []uint16, Map map[string]uint16) (map[uint16][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringUint16 checks which values of an uint16 slice are not values of map[string]uint16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringUint16(Slice []uint16, Map map[string]uint16) (map[uint16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringUint16 checks if all key-value pairs from one map[string]uint16 are in another map[string]uint16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringUint16(Map1, Map2 map[string]uint16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringUint16 checks if any key-value pair from one map[string]uint16 is in another map[string]uint16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringUint16(Map1, Map2 map[string]uint16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringUint16 checks which key-value pairs from one map[string]uint16 are in another map[string]uint16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringUint16(Map1, Map2 map[string]uint16) (map[string]uint16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringUint16 checks which key-value pairs from one map[string]uint16 are not in another map[string]uint16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringUint16(Map1, Map2 map[string]uint16) (map[string]PairMismatch[uint16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringUint16 checks if all values of map[string]uint16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringUint16(Map map[string]uint16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringUint32 compares two maps map[string]uint32.
+func AreEqualMapsStringUint32(Map1, Map2 map[string]uint32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringUint32 checks if an uint32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringUint32(X uint32, Map map[string]uint32) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringUint32 checks if any of the values of an uint32 slice is a value of map[string]uint32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringUint32(Slice []uint32, Map map[string]uint32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringUint32 checks if all values of an uint32 slice are values of map[string]uint32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringUint32(Slice []uint32, Map map[string]uint32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringUint32 checks which values of an uint32 slice are values of map[string]uint32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringUint32(Slice []uint32, Map map[string]uint32) (map[uint32][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringUint32 checks which values of an uint32 slice are not values of map[string]uint32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringUint32(Slice []uint32, Map map[string]uint32) (map[uint32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringUint32 checks if all key-value pairs from one map[string]uint32 are in another map[string]uint32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringUint32(Map1, Map2 map[string]uint32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringUint32 checks if any key-value pair from one map[string]uint32 is in another map[string]uint32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringUint32(Map1, Map2 map[string]uint32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringUint32 checks which key-value pairs from one map[string]uint32 are in another map[string]uint32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringUint32(Map1, Map2 map[string]uint32) (map[string]uint32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringUint32 checks which key-value pairs from one map[string]uint32 are not in another map[string]uint32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringUint32(Map1, Map2 map[string]uint32) (map[string]PairMismatch[uint32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringUint32 checks if all values of map[string]uint32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringUint32(Map map[string]uint32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringUint64 compares two maps map[string]uint64.
+func AreEqualMapsStringUint64(Map1, Map2 map[string]uint64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapStringUint64 checks if an uint64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringUint64(X uint64, Map map[string]uint64) ([]string, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapStringUint64 checks if any of the values of an uint64 slice is a value of map[string]uint64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringUint64(Slice []uint64, Map map[string]uint64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapStringUint64 checks if all values of an uint64 slice are values of map[string]uint64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringUint64(Slice []uint64, Map map[string]uint64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapStringUint64 checks which values of an uint64 slice are values of map[string]uint64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringUint64(Slice []uint64, Map map[string]uint64) (map[uint64][]string, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapStringUint64 checks which values of an uint64 slice are not values of map[string]uint64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringUint64(Slice []uint64, Map map[string]uint64) (map[uint64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapStringUint64 checks if all key-value pairs from one map[string]uint64 are in another map[string]uint64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringUint64(Map1, Map2 map[string]uint64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapStringUint64 checks if any key-value pair from one map[string]uint64 is in another map[string]uint64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringUint64(Map1, Map2 map[string]uint64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapStringUint64 checks which key-value pairs from one map[string]uint64 are in another map[string]uint64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringUint64(Map1, Map2 map[string]uint64) (map[string]uint64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapStringUint64 checks which key-value pairs from one map[string]uint64 are not in another map[string]uint64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringUint64(Map1, Map2 map[string]uint64) (map[string]PairMismatch[uint64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapStringUint64 checks if all values of map[string]uint64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringUint64(Map map[string]uint64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsStringFloat32 compares two maps map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsStringFloat32(Map1, Map2 map[string]float32, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapStringFloat32 checks if a float32 (X) is among the map's values.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapStringFloat32(X float32, Map map[string]float32, Epsilon float64) ([]string, bool) {
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
+
+// AnyValueInMapStringFloat32 checks if any of the values of a float32 slice is a value of map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapStringFloat32(Slice []float32, Map map[string]float32, Epsilon float64) bool {
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInMapStringFloat32 checks if all values of a float32 slice are values of map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapStringFloat32(Slice []float32, Map map[string]float32, Epsilon float64) bool {
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesInMapStringFloat32 checks which values of a float32 slice are values of map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapStringFloat32(Slice []float32, Map map[string]float32, Epsilon float64) (map[float32][]string, bool) {
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesNotInMapStringFloat32 checks which values of a float32 slice are not values of map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapStringFloat32(Slice []float32, Map map[string]float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllKeyValuePairsInMapStringFloat32 checks if all key-value pairs from one map[string]float32 are in another map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapStringFloat32(Map1, Map2 map[string]float32, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AnyKeyValuePairInMapStringFloat32 checks if any key-value pair from one map[string]float32 is in another map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapStringFloat32(Map1, Map2 map[string]float32, Epsilon float64) bool {
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsInMapStringFloat32 checks which key-value pairs from one map[string]float32 are in another map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapStringFloat32(Map1, Map2 map[string]float32, Epsilon float64) (map[string]float32, bool) {
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsNotInMapStringFloat32 checks which key-value pairs from one map[string]float32 are not in another map[string]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapStringFloat32(Map1, Map2 map[string]float32, Epsilon float64) (map[string]PairMismatch[float32], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// IsUniqueMapStringFloat32 checks if all values of map[string]float32 are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapStringFloat32(Map map[string]float32, Epsilon float64) bool {
+	return IsUniqueMapFloat(Map, Epsilon)
+}
+
+// AreEqualMapsIntInt8 compares two maps map[int]int8.
+func AreEqualMapsIntInt8(Map1, Map2 map[int]int8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntInt8 checks if an int8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntInt8(X int8, Map map[int]int8) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntInt8 checks if any of the values of an int8 slice is a value of map[int]int8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntInt8(Slice []int8, Map map[int]int8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntInt8 checks if all values of an int8 slice are values of map[int]int8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntInt8(Slice []int8, Map map[int]int8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntInt8 checks which values of an int8 slice are values of map[int]int8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntInt8(Slice []int8, Map map[int]int8) (map[int8][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntInt8 checks which values of an int8 slice are not values of map[int]int8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntInt8(Slice []int8, Map map[int]int8) (map[int8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntInt8 checks if all key-value pairs from one map[int]int8 are in another map[int]int8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntInt8(Map1, Map2 map[int]int8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntInt8 checks if any key-value pair from one map[int]int8 is in another map[int]int8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntInt8(Map1, Map2 map[int]int8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntInt8 checks which key-value pairs from one map[int]int8 are in another map[int]int8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntInt8(Map1, Map2 map[int]int8) (map[int]int8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntInt8 checks which key-value pairs from one map[int]int8 are not in another map[int]int8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntInt8(Map1, Map2 map[int]int8) (map[int]PairMismatch[int8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntInt8 checks if all values of map[int]int8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntInt8(Map map[int]int8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntInt16 compares two maps map[int]int16.
+func AreEqualMapsIntInt16(Map1, Map2 map[int]int16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntInt16 checks if an int16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntInt16(X int16, Map map[int]int16) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntInt16 checks if any of the values of an int16 slice is a value of map[int]int16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntInt16(Slice []int16, Map map[int]int16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntInt16 checks if all values of an int16 slice are values of map[int]int16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntInt16(Slice []int16, Map map[int]int16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntInt16 checks which values of an int16 slice are values of map[int]int16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntInt16(Slice []int16, Map map[int]int16) (map[int16][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntInt16 checks which values of an int16 slice are not values of map[int]int16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntInt16(Slice []int16, Map map[int]int16) (map[int16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntInt16 checks if all key-value pairs from one map[int]int16 are in another map[int]int16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntInt16(Map1, Map2 map[int]int16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntInt16 checks if any key-value pair from one map[int]int16 is in another map[int]int16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntInt16(Map1, Map2 map[int]int16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntInt16 checks which key-value pairs from one map[int]int16 are in another map[int]int16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntInt16(Map1, Map2 map[int]int16) (map[int]int16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntInt16 checks which key-value pairs from one map[int]int16 are not in another map[int]int16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntInt16(Map1, Map2 map[int]int16) (map[int]PairMismatch[int16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntInt16 checks if all values of map[int]int16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntInt16(Map map[int]int16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntInt32 compares two maps map[int]int32.
+func AreEqualMapsIntInt32(Map1, Map2 map[int]int32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntInt32 checks if an int32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntInt32(X int32, Map map[int]int32) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntInt32 checks if any of the values of an int32 slice is a value of map[int]int32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntInt32(Slice []int32, Map map[int]int32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntInt32 checks if all values of an int32 slice are values of map[int]int32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntInt32(Slice []int32, Map map[int]int32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntInt32 checks which values of an int32 slice are values of map[int]int32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntInt32(Slice []int32, Map map[int]int32) (map[int32][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntInt32 checks which values of an int32 slice are not values of map[int]int32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntInt32(Slice []int32, Map map[int]int32) (map[int32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntInt32 checks if all key-value pairs from one map[int]int32 are in another map[int]int32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntInt32(Map1, Map2 map[int]int32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntInt32 checks if any key-value pair from one map[int]int32 is in another map[int]int32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntInt32(Map1, Map2 map[int]int32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntInt32 checks which key-value pairs from one map[int]int32 are in another map[int]int32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntInt32(Map1, Map2 map[int]int32) (map[int]int32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntInt32 checks which key-value pairs from one map[int]int32 are not in another map[int]int32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntInt32(Map1, Map2 map[int]int32) (map[int]PairMismatch[int32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntInt32 checks if all values of map[int]int32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntInt32(Map map[int]int32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntInt64 compares two maps map[int]int64.
+func AreEqualMapsIntInt64(Map1, Map2 map[int]int64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntInt64 checks if an int64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntInt64(X int64, Map map[int]int64) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntInt64 checks if any of the values of an int64 slice is a value of map[int]int64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntInt64(Slice []int64, Map map[int]int64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntInt64 checks if all values of an int64 slice are values of map[int]int64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntInt64(Slice []int64, Map map[int]int64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntInt64 checks which values of an int64 slice are values of map[int]int64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntInt64(Slice []int64, Map map[int]int64) (map[int64][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntInt64 checks which values of an int64 slice are not values of map[int]int64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntInt64(Slice []int64, Map map[int]int64) (map[int64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntInt64 checks if all key-value pairs from one map[int]int64 are in another map[int]int64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntInt64(Map1, Map2 map[int]int64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntInt64 checks if any key-value pair from one map[int]int64 is in another map[int]int64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntInt64(Map1, Map2 map[int]int64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntInt64 checks which key-value pairs from one map[int]int64 are in another map[int]int64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntInt64(Map1, Map2 map[int]int64) (map[int]int64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntInt64 checks which key-value pairs from one map[int]int64 are not in another map[int]int64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntInt64(Map1, Map2 map[int]int64) (map[int]PairMismatch[int64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntInt64 checks if all values of map[int]int64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntInt64(Map map[int]int64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntUint compares two maps map[int]uint.
+func AreEqualMapsIntUint(Map1, Map2 map[int]uint) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntUint checks if an uint (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntUint(X uint, Map map[int]uint) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntUint checks if any of the values of an uint slice is a value of map[int]uint.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntUint(Slice []uint, Map map[int]uint) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntUint checks if all values of an uint slice are values of map[int]uint.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntUint(Slice []uint, Map map[int]uint) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntUint checks which values of an uint slice are values of map[int]uint.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntUint(Slice []uint, Map map[int]uint) (map[uint][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntUint checks which values of an uint slice are not values of map[int]uint.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntUint(Slice []uint, Map map[int]uint) (map[uint][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntUint checks if all key-value pairs from one map[int]uint are in another map[int]uint.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntUint(Map1, Map2 map[int]uint) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntUint checks if any key-value pair from one map[int]uint is in another map[int]uint.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntUint(Map1, Map2 map[int]uint) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntUint checks which key-value pairs from one map[int]uint are in another map[int]uint.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntUint(Map1, Map2 map[int]uint) (map[int]uint, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntUint checks which key-value pairs from one map[int]uint are not in another map[int]uint.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntUint(Map1, Map2 map[int]uint) (map[int]PairMismatch[uint], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntUint checks if all values of map[int]uint are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntUint(Map map[int]uint) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntUint8 compares two maps map[int]uint8.
+func AreEqualMapsIntUint8(Map1, Map2 map[int]uint8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntUint8 checks if an uint8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntUint8(X uint8, Map map[int]uint8) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntUint8 checks if any of the values of an uint8 slice is a value of map[int]uint8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntUint8(Slice []uint8, Map map[int]uint8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntUint8 checks if all values of an uint8 slice are values of map[int]uint8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntUint8(Slice []uint8, Map map[int]uint8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntUint8 checks which values of an uint8 slice are values of map[int]uint8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntUint8(Slice []uint8, Map map[int]uint8) (map[uint8][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntUint8 checks which values of an uint8 slice are not values of map[int]uint8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntUint8(Slice []uint8, Map map[int]uint8) (map[uint8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntUint8 checks if all key-value pairs from one map[int]uint8 are in another map[int]uint8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntUint8(Map1, Map2 map[int]uint8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntUint8 checks if any key-value pair from one map[int]uint8 is in another map[int]uint8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntUint8(Map1, Map2 map[int]uint8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntUint8 checks which key-value pairs from one map[int]uint8 are in another map[int]uint8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntUint8(Map1, Map2 map[int]uint8) (map[int]uint8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntUint8 checks which key-value pairs from one map[int]uint8 are not in another map[int]uint8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntUint8(Map1, Map2 map[int]uint8) (map[int]PairMismatch[uint8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntUint8 checks if all values of map[int]uint8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntUint8(Map map[int]uint8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntUint16 compares two maps map[int]uint16.
+func AreEqualMapsIntUint16(Map1, Map2 map[int]uint16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntUint16 checks if an uint16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntUint16(X uint16, Map map[int]uint16) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntUint16 checks if any of the values of an uint16 slice is a value of map[int]uint16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntUint16(Slice []uint16, Map map[int]uint16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntUint16 checks if all values of an uint16 slice are values of map[int]uint16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntUint16(Slice []uint16, Map map[int]uint16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntUint16 checks which values of an uint16 slice are values of map[int]uint16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntUint16(Slice []uint16, Map map[int]uint16) (map[uint16][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntUint16 checks which values of an uint16 slice are not values of map[int]uint16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntUint16(Slice []uint16, Map map[int]uint16) (map[uint16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntUint16 checks if all key-value pairs from one map[int]uint16 are in another map[int]uint16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntUint16(Map1, Map2 map[int]uint16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntUint16 checks if any key-value pair from one map[int]uint16 is in another map[int]uint16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntUint16(Map1, Map2 map[int]uint16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntUint16 checks which key-value pairs from one map[int]uint16 are in another map[int]uint16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntUint16(Map1, Map2 map[int]uint16) (map[int]uint16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntUint16 checks which key-value pairs from one map[int]uint16 are not in another map[int]uint16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntUint16(Map1, Map2 map[int]uint16) (map[int]PairMismatch[uint16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntUint16 checks if all values of map[int]uint16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntUint16(Map map[int]uint16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntUint32 compares two maps map[int]uint32.
+func AreEqualMapsIntUint32(Map1, Map2 map[int]uint32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntUint32 checks if an uint32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntUint32(X uint32, Map map[int]uint32) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntUint32 checks if any of the values of an uint32 slice is a value of map[int]uint32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntUint32(Slice []uint32, Map map[int]uint32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntUint32 checks if all values of an uint32 slice are values of map[int]uint32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntUint32(Slice []uint32, Map map[int]uint32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntUint32 checks which values of an uint32 slice are values of map[int]uint32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntUint32(Slice []uint32, Map map[int]uint32) (map[uint32][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntUint32 checks which values of an uint32 slice are not values of map[int]uint32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntUint32(Slice []uint32, Map map[int]uint32) (map[uint32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntUint32 checks if all key-value pairs from one map[int]uint32 are in another map[int]uint32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntUint32(Map1, Map2 map[int]uint32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntUint32 checks if any key-value pair from one map[int]uint32 is in another map[int]uint32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntUint32(Map1, Map2 map[int]uint32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntUint32 checks which key-value pairs from one map[int]uint32 are in another map[int]uint32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntUint32(Map1, Map2 map[int]uint32) (map[int]uint32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntUint32 checks which key-value pairs from one map[int]uint32 are not in another map[int]uint32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntUint32(Map1, Map2 map[int]uint32) (map[int]PairMismatch[uint32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntUint32 checks if all values of map[int]uint32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntUint32(Map map[int]uint32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntUint64 compares two maps map[int]uint64.
+func AreEqualMapsIntUint64(Map1, Map2 map[int]uint64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapIntUint64 checks if an uint64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntUint64(X uint64, Map map[int]uint64) ([]int, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapIntUint64 checks if any of the values of an uint64 slice is a value of map[int]uint64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntUint64(Slice []uint64, Map map[int]uint64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapIntUint64 checks if all values of an uint64 slice are values of map[int]uint64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntUint64(Slice []uint64, Map map[int]uint64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapIntUint64 checks which values of an uint64 slice are values of map[int]uint64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntUint64(Slice []uint64, Map map[int]uint64) (map[uint64][]int, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapIntUint64 checks which values of an uint64 slice are not values of map[int]uint64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntUint64(Slice []uint64, Map map[int]uint64) (map[uint64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapIntUint64 checks if all key-value pairs from one map[int]uint64 are in another map[int]uint64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntUint64(Map1, Map2 map[int]uint64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapIntUint64 checks if any key-value pair from one map[int]uint64 is in another map[int]uint64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntUint64(Map1, Map2 map[int]uint64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapIntUint64 checks which key-value pairs from one map[int]uint64 are in another map[int]uint64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntUint64(Map1, Map2 map[int]uint64) (map[int]uint64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapIntUint64 checks which key-value pairs from one map[int]uint64 are not in another map[int]uint64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntUint64(Map1, Map2 map[int]uint64) (map[int]PairMismatch[uint64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapIntUint64 checks if all values of map[int]uint64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntUint64(Map map[int]uint64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsIntFloat32 compares two maps map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsIntFloat32(Map1, Map2 map[int]float32, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapIntFloat32 checks if a float32 (X) is among the map's values.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapIntFloat32(X float32, Map map[int]float32, Epsilon float64) ([]int, bool) {
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
+
+// AnyValueInMapIntFloat32 checks if any of the values of a float32 slice is a value of map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapIntFloat32(Slice []float32, Map map[int]float32, Epsilon float64) bool {
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInMapIntFloat32 checks if all values of a float32 slice are values of map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapIntFloat32(Slice []float32, Map map[int]float32, Epsilon float64) bool {
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesInMapIntFloat32 checks which values of a float32 slice are values of map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapIntFloat32(Slice []float32, Map map[int]float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesNotInMapIntFloat32 checks which values of a float32 slice are not values of map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapIntFloat32(Slice []float32, Map map[int]float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllKeyValuePairsInMapIntFloat32 checks if all key-value pairs from one map[int]float32 are in another map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapIntFloat32(Map1, Map2 map[int]float32, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AnyKeyValuePairInMapIntFloat32 checks if any key-value pair from one map[int]float32 is in another map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapIntFloat32(Map1, Map2 map[int]float32, Epsilon float64) bool {
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsInMapIntFloat32 checks which key-value pairs from one map[int]float32 are in another map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapIntFloat32(Map1, Map2 map[int]float32, Epsilon float64) (map[int]float32, bool) {
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsNotInMapIntFloat32 checks which key-value pairs from one map[int]float32 are not in another map[int]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapIntFloat32(Map1, Map2 map[int]float32, Epsilon float64) (map[int]PairMismatch[float32], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// IsUniqueMapIntFloat32 checks if all values of map[int]float32 are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapIntFloat32(Map map[int]float32, Epsilon float64) bool {
+	return IsUniqueMapFloat(Map, Epsilon)
+}
+
+// AreEqualMapsInt64String compares two maps map[int64]string.
+func AreEqualMapsInt64String(Map1, Map2 map[int64]string) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64String checks if a string (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64String(X string, Map map[int64]string) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64String checks if any of the values of a string slice is a value of map[int64]string.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64String(Slice []string, Map map[int64]string) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64String checks if all values of a string slice are values of map[int64]string.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64String(Slice []string, Map map[int64]string) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64String checks which values of a string slice are values of map[int64]string.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64String(Slice []string, Map map[int64]string) (map[string][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64String checks which values of a string slice are not values of map[int64]string.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64String(Slice []string, Map map[int64]string) (map[string][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64String checks if all key-value pairs from one map[int64]string are in another map[int64]string.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64String(Map1, Map2 map[int64]string) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64String checks if any key-value pair from one map[int64]string is in another map[int64]string.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64String(Map1, Map2 map[int64]string) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64String checks which key-value pairs from one map[int64]string are in another map[int64]string.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64String(Map1, Map2 map[int64]string) (map[int64]string, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64String checks which key-value pairs from one map[int64]string are not in another map[int64]string.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64String(Map1, Map2 map[int64]string) (map[int64]PairMismatch[string], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64String checks if all values of map[int64]string are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64String(Map map[int64]string) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Int compares two maps map[int64]int.
+func AreEqualMapsInt64Int(Map1, Map2 map[int64]int) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Int checks if an int (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Int(X int, Map map[int64]int) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Int checks if any of the values of an int slice is a value of map[int64]int.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Int(Slice []int, Map map[int64]int) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Int checks if all values of an int slice are values of map[int64]int.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Int(Slice []int, Map map[int64]int) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Int checks which values of an int slice are values of map[int64]int.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Int(Slice []int, Map map[int64]int) (map[int][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Int checks which values of an int slice are not values of map[int64]int.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Int(Slice []int, Map map[int64]int) (map[int][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Int checks if all key-value pairs from one map[int64]int are in another map[int64]int.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Int(Map1, Map2 map[int64]int) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Int checks if any key-value pair from one map[int64]int is in another map[int64]int.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Int(Map1, Map2 map[int64]int) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Int checks which key-value pairs from one map[int64]int are in another map[int64]int.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Int(Map1, Map2 map[int64]int) (map[int64]int, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Int checks which key-value pairs from one map[int64]int are not in another map[int64]int.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Int(Map1, Map2 map[int64]int) (map[int64]PairMismatch[int], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Int checks if all values of map[int64]int are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Int(Map map[int64]int) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Float64 compares two maps map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsInt64Float64(Map1, Map2 map[int64]float64, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapInt64Float64 checks if a float64 (X) is among the map's values.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Float64(X float64, Map map[int64]float64, Epsilon float64) ([]int64, bool) {
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
+
+// AnyValueInMapInt64Float64 checks if any of the values of a float64 slice is a value of map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Float64(Slice []float64, Map map[int64]float64, Epsilon float64) bool {
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInMapInt64Float64 checks if all values of a float64 slice are values of map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Float64(Slice []float64, Map map[int64]float64, Epsilon float64) bool {
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesInMapInt64Float64 checks which values of a float64 slice are values of map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Float64(Slice []float64, Map map[int64]float64, Epsilon float64) (map[float64][]int64, bool) {
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesNotInMapInt64Float64 checks which values of a float64 slice are not values of map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Float64(Slice []float64, Map map[int64]float64, Epsilon float64) (map[float64][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllKeyValuePairsInMapInt64Float64 checks if all key-value pairs from one map[int64]float64 are in another map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Float64(Map1, Map2 map[int64]float64, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AnyKeyValuePairInMapInt64Float64 checks if any key-value pair from one map[int64]float64 is in another map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Float64(Map1, Map2 map[int64]float64, Epsilon float64) bool {
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsInMapInt64Float64 checks which key-value pairs from one map[int64]float64 are in another map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Float64(Map1, Map2 map[int64]float64, Epsilon float64) (map[int64]float64, bool) {
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsNotInMapInt64Float64 checks which key-value pairs from one map[int64]float64 are not in another map[int64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Float64(Map1, Map2 map[int64]float64, Epsilon float64) (map[int64]PairMismatch[float64], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// IsUniqueMapInt64Float64 checks if all values of map[int64]float64 are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Float64(Map map[int64]float64, Epsilon float64) bool {
+	return IsUniqueMapFloat(Map, Epsilon)
+}
+
+// AreEqualMapsInt64Int8 compares two maps map[int64]int8.
+func AreEqualMapsInt64Int8(Map1, Map2 map[int64]int8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Int8 checks if an int8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Int8(X int8, Map map[int64]int8) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Int8 checks if any of the values of an int8 slice is a value of map[int64]int8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Int8(Slice []int8, Map map[int64]int8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Int8 checks if all values of an int8 slice are values of map[int64]int8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Int8(Slice []int8, Map map[int64]int8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Int8 checks which values of an int8 slice are values of map[int64]int8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Int8(Slice []int8, Map map[int64]int8) (map[int8][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Int8 checks which values of an int8 slice are not values of map[int64]int8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Int8(Slice []int8, Map map[int64]int8) (map[int8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Int8 checks if all key-value pairs from one map[int64]int8 are in another map[int64]int8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Int8(Map1, Map2 map[int64]int8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Int8 checks if any key-value pair from one map[int64]int8 is in another map[int64]int8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Int8(Map1, Map2 map[int64]int8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Int8 checks which key-value pairs from one map[int64]int8 are in another map[int64]int8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Int8(Map1, Map2 map[int64]int8) (map[int64]int8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Int8 checks which key-value pairs from one map[int64]int8 are not in another map[int64]int8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Int8(Map1, Map2 map[int64]int8) (map[int64]PairMismatch[int8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Int8 checks if all values of map[int64]int8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Int8(Map map[int64]int8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Int16 compares two maps map[int64]int16.
+func AreEqualMapsInt64Int16(Map1, Map2 map[int64]int16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Int16 checks if an int16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Int16(X int16, Map map[int64]int16) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Int16 checks if any of the values of an int16 slice is a value of map[int64]int16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Int16(Slice []int16, Map map[int64]int16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Int16 checks if all values of an int16 slice are values of map[int64]int16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Int16(Slice []int16, Map map[int64]int16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Int16 checks which values of an int16 slice are values of map[int64]int16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Int16(Slice []int16, Map map[int64]int16) (map[int16][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Int16 checks which values of an int16 slice are not values of map[int64]int16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Int16(Slice []int16, Map map[int64]int16) (map[int16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Int16 checks if all key-value pairs from one map[int64]int16 are in another map[int64]int16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Int16(Map1, Map2 map[int64]int16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Int16 checks if any key-value pair from one map[int64]int16 is in another map[int64]int16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Int16(Map1, Map2 map[int64]int16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Int16 checks which key-value pairs from one map[int64]int16 are in another map[int64]int16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Int16(Map1, Map2 map[int64]int16) (map[int64]int16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Int16 checks which key-value pairs from one map[int64]int16 are not in another map[int64]int16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Int16(Map1, Map2 map[int64]int16) (map[int64]PairMismatch[int16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Int16 checks if all values of map[int64]int16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Int16(Map map[int64]int16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Int32 compares two maps map[int64]int32.
+func AreEqualMapsInt64Int32(Map1, Map2 map[int64]int32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Int32 checks if an int32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Int32(X int32, Map map[int64]int32) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Int32 checks if any of the values of an int32 slice is a value of map[int64]int32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Int32(Slice []int32, Map map[int64]int32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Int32 checks if all values of an int32 slice are values of map[int64]int32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Int32(Slice []int32, Map map[int64]int32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Int32 checks which values of an int32 slice are values of map[int64]int32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Int32(Slice []int32, Map map[int64]int32) (map[int32][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Int32 checks which values of an int32 slice are not values of map[int64]int32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Int32(Slice []int32, Map map[int64]int32) (map[int32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Int32 checks if all key-value pairs from one map[int64]int32 are in another map[int64]int32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Int32(Map1, Map2 map[int64]int32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Int32 checks if any key-value pair from one map[int64]int32 is in another map[int64]int32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Int32(Map1, Map2 map[int64]int32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Int32 checks which key-value pairs from one map[int64]int32 are in another map[int64]int32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Int32(Map1, Map2 map[int64]int32) (map[int64]int32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Int32 checks which key-value pairs from one map[int64]int32 are not in another map[int64]int32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Int32(Map1, Map2 map[int64]int32) (map[int64]PairMismatch[int32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Int32 checks if all values of map[int64]int32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Int32(Map map[int64]int32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Int64 compares two maps map[int64]int64.
+func AreEqualMapsInt64Int64(Map1, Map2 map[int64]int64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Int64 checks if an int64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Int64(X int64, Map map[int64]int64) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Int64 checks if any of the values of an int64 slice is a value of map[int64]int64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Int64(Slice []int64, Map map[int64]int64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Int64 checks if all values of an int64 slice are values of map[int64]int64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Int64(Slice []int64, Map map[int64]int64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Int64 checks which values of an int64 slice are values of map[int64]int64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Int64(Slice []int64, Map map[int64]int64) (map[int64][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Int64 checks which values of an int64 slice are not values of map[int64]int64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Int64(Slice []int64, Map map[int64]int64) (map[int64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Int64 checks if all key-value pairs from one map[int64]int64 are in another map[int64]int64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Int64(Map1, Map2 map[int64]int64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Int64 checks if any key-value pair from one map[int64]int64 is in another map[int64]int64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Int64(Map1, Map2 map[int64]int64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Int64 checks which key-value pairs from one map[int64]int64 are in another map[int64]int64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Int64(Map1, Map2 map[int64]int64) (map[int64]int64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Int64 checks which key-value pairs from one map[int64]int64 are not in another map[int64]int64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Int64(Map1, Map2 map[int64]int64) (map[int64]PairMismatch[int64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Int64 checks if all values of map[int64]int64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Int64(Map map[int64]int64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Uint compares two maps map[int64]uint.
+func AreEqualMapsInt64Uint(Map1, Map2 map[int64]uint) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Uint checks if an uint (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Uint(X uint, Map map[int64]uint) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Uint checks if any of the values of an uint slice is a value of map[int64]uint.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Uint(Slice []uint, Map map[int64]uint) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Uint checks if all values of an uint slice are values of map[int64]uint.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Uint(Slice []uint, Map map[int64]uint) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Uint checks which values of an uint slice are values of map[int64]uint.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Uint(Slice []uint, Map map[int64]uint) (map[uint][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Uint checks which values of an uint slice are not values of map[int64]uint.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Uint(Slice []uint, Map map[int64]uint) (map[uint][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Uint checks if all key-value pairs from one map[int64]uint are in another map[int64]uint.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Uint(Map1, Map2 map[int64]uint) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Uint checks if any key-value pair from one map[int64]uint is in another map[int64]uint.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Uint(Map1, Map2 map[int64]uint) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Uint checks which key-value pairs from one map[int64]uint are in another map[int64]uint.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Uint(Map1, Map2 map[int64]uint) (map[int64]uint, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Uint checks which key-value pairs from one map[int64]uint are not in another map[int64]uint.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Uint(Map1, Map2 map[int64]uint) (map[int64]PairMismatch[uint], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Uint checks if all values of map[int64]uint are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Uint(Map map[int64]uint) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Uint8 compares two maps map[int64]uint8.
+func AreEqualMapsInt64Uint8(Map1, Map2 map[int64]uint8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Uint8 checks if an uint8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Uint8(X uint8, Map map[int64]uint8) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Uint8 checks if any of the values of an uint8 slice is a value of map[int64]uint8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Uint8(Slice []uint8, Map map[int64]uint8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Uint8 checks if all values of an uint8 slice are values of map[int64]uint8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Uint8(Slice []uint8, Map map[int64]uint8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Uint8 checks which values of an uint8 slice are values of map[int64]uint8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Uint8(Slice []uint8, Map map[int64]uint8) (map[uint8][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Uint8 checks which values of an uint8 slice are not values of map[int64]uint8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Uint8(Slice []uint8, Map map[int64]uint8) (map[uint8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Uint8 checks if all key-value pairs from one map[int64]uint8 are in another map[int64]uint8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Uint8(Map1, Map2 map[int64]uint8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Uint8 checks if any key-value pair from one map[int64]uint8 is in another map[int64]uint8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Uint8(Map1, Map2 map[int64]uint8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Uint8 checks which key-value pairs from one map[int64]uint8 are in another map[int64]uint8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Uint8(Map1, Map2 map[int64]uint8) (map[int64]uint8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Uint8 checks which key-value pairs from one map[int64]uint8 are not in another map[int64]uint8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Uint8(Map1, Map2 map[int64]uint8) (map[int64]PairMismatch[uint8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Uint8 checks if all values of map[int64]uint8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Uint8(Map map[int64]uint8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Uint16 compares two maps map[int64]uint16.
+func AreEqualMapsInt64Uint16(Map1, Map2 map[int64]uint16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Uint16 checks if an uint16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Uint16(X uint16, Map map[int64]uint16) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Uint16 checks if any of the values of an uint16 slice is a value of map[int64]uint16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Uint16(Slice []uint16, Map map[int64]uint16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Uint16 checks if all values of an uint16 slice are values of map[int64]uint16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Uint16(Slice []uint16, Map map[int64]uint16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Uint16 checks which values of an uint16 slice are values of map[int64]uint16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Uint16(Slice []uint16, Map map[int64]uint16) (map[uint16][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Uint16 checks which values of an uint16 slice are not values of map[int64]uint16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Uint16(Slice []uint16, Map map[int64]uint16) (map[uint16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Uint16 checks if all key-value pairs from one map[int64]uint16 are in another map[int64]uint16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Uint16(Map1, Map2 map[int64]uint16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Uint16 checks if any key-value pair from one map[int64]uint16 is in another map[int64]uint16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Uint16(Map1, Map2 map[int64]uint16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Uint16 checks which key-value pairs from one map[int64]uint16 are in another map[int64]uint16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Uint16(Map1, Map2 map[int64]uint16) (map[int64]uint16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Uint16 checks which key-value pairs from one map[int64]uint16 are not in another map[int64]uint16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Uint16(Map1, Map2 map[int64]uint16) (map[int64]PairMismatch[uint16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Uint16 checks if all values of map[int64]uint16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Uint16(Map map[int64]uint16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Uint32 compares two maps map[int64]uint32.
+func AreEqualMapsInt64Uint32(Map1, Map2 map[int64]uint32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Uint32 checks if an uint32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Uint32(X uint32, Map map[int64]uint32) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Uint32 checks if any of the values of an uint32 slice is a value of map[int64]uint32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Uint32(Slice []uint32, Map map[int64]uint32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Uint32 checks if all values of an uint32 slice are values of map[int64]uint32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Uint32(Slice []uint32, Map map[int64]uint32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Uint32 checks which values of an uint32 slice are values of map[int64]uint32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Uint32(Slice []uint32, Map map[int64]uint32) (map[uint32][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Uint32 checks which values of an uint32 slice are not values of map[int64]uint32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Uint32(Slice []uint32, Map map[int64]uint32) (map[uint32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Uint32 checks if all key-value pairs from one map[int64]uint32 are in another map[int64]uint32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Uint32(Map1, Map2 map[int64]uint32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Uint32 checks if any key-value pair from one map[int64]uint32 is in another map[int64]uint32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Uint32(Map1, Map2 map[int64]uint32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Uint32 checks which key-value pairs from one map[int64]uint32 are in another map[int64]uint32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Uint32(Map1, Map2 map[int64]uint32) (map[int64]uint32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Uint32 checks which key-value pairs from one map[int64]uint32 are not in another map[int64]uint32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Uint32(Map1, Map2 map[int64]uint32) (map[int64]PairMismatch[uint32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Uint32 checks if all values of map[int64]uint32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Uint32(Map map[int64]uint32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Uint64 compares two maps map[int64]uint64.
+func AreEqualMapsInt64Uint64(Map1, Map2 map[int64]uint64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapInt64Uint64 checks if an uint64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Uint64(X uint64, Map map[int64]uint64) ([]int64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapInt64Uint64 checks if any of the values of an uint64 slice is a value of map[int64]uint64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Uint64(Slice []uint64, Map map[int64]uint64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapInt64Uint64 checks if all values of an uint64 slice are values of map[int64]uint64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Uint64(Slice []uint64, Map map[int64]uint64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapInt64Uint64 checks which values of an uint64 slice are values of map[int64]uint64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Uint64(Slice []uint64, Map map[int64]uint64) (map[uint64][]int64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapInt64Uint64 checks which values of an uint64 slice are not values of map[int64]uint64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Uint64(Slice []uint64, Map map[int64]uint64) (map[uint64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapInt64Uint64 checks if all key-value pairs from one map[int64]uint64 are in another map[int64]uint64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Uint64(Map1, Map2 map[int64]uint64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapInt64Uint64 checks if any key-value pair from one map[int64]uint64 is in another map[int64]uint64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Uint64(Map1, Map2 map[int64]uint64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapInt64Uint64 checks which key-value pairs from one map[int64]uint64 are in another map[int64]uint64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Uint64(Map1, Map2 map[int64]uint64) (map[int64]uint64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapInt64Uint64 checks which key-value pairs from one map[int64]uint64 are not in another map[int64]uint64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Uint64(Map1, Map2 map[int64]uint64) (map[int64]PairMismatch[uint64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapInt64Uint64 checks if all values of map[int64]uint64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Uint64(Map map[int64]uint64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsInt64Float32 compares two maps map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsInt64Float32(Map1, Map2 map[int64]float32, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapInt64Float32 checks if a float32 (X) is among the map's values.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapInt64Float32(X float32, Map map[int64]float32, Epsilon float64) ([]int64, bool) {
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
+
+// AnyValueInMapInt64Float32 checks if any of the values of a float32 slice is a value of map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapInt64Float32(Slice []float32, Map map[int64]float32, Epsilon float64) bool {
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInMapInt64Float32 checks if all values of a float32 slice are values of map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapInt64Float32(Slice []float32, Map map[int64]float32, Epsilon float64) bool {
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesInMapInt64Float32 checks which values of a float32 slice are values of map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapInt64Float32(Slice []float32, Map map[int64]float32, Epsilon float64) (map[float32][]int64, bool) {
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesNotInMapInt64Float32 checks which values of a float32 slice are not values of map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapInt64Float32(Slice []float32, Map map[int64]float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllKeyValuePairsInMapInt64Float32 checks if all key-value pairs from one map[int64]float32 are in another map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapInt64Float32(Map1, Map2 map[int64]float32, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AnyKeyValuePairInMapInt64Float32 checks if any key-value pair from one map[int64]float32 is in another map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapInt64Float32(Map1, Map2 map[int64]float32, Epsilon float64) bool {
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsInMapInt64Float32 checks which key-value pairs from one map[int64]float32 are in another map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapInt64Float32(Map1, Map2 map[int64]float32, Epsilon float64) (map[int64]float32, bool) {
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsNotInMapInt64Float32 checks which key-value pairs from one map[int64]float32 are not in another map[int64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapInt64Float32(Map1, Map2 map[int64]float32, Epsilon float64) (map[int64]PairMismatch[float32], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// IsUniqueMapInt64Float32 checks if all values of map[int64]float32 are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapInt64Float32(Map map[int64]float32, Epsilon float64) bool {
+	return IsUniqueMapFloat(Map, Epsilon)
+}
+
+// AreEqualMapsUint64String compares two maps map[uint64]string.
+func AreEqualMapsUint64String(Map1, Map2 map[uint64]string) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64String checks if a string (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64String(X string, Map map[uint64]string) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64String checks if any of the values of a string slice is a value of map[uint64]string.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64String(Slice []string, Map map[uint64]string) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64String checks if all values of a string slice are values of map[uint64]string.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64String(Slice []string, Map map[uint64]string) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64String checks which values of a string slice are values of map[uint64]string.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64String(Slice []string, Map map[uint64]string) (map[string][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64String checks which values of a string slice are not values of map[uint64]string.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64String(Slice []string, Map map[uint64]string) (map[string][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64String checks if all key-value pairs from one map[uint64]string are in another map[uint64]string.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64String(Map1, Map2 map[uint64]string) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64String checks if any key-value pair from one map[uint64]string is in another map[uint64]string.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64String(Map1, Map2 map[uint64]string) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64String checks which key-value pairs from one map[uint64]string are in another map[uint64]string.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64String(Map1, Map2 map[uint64]string) (map[uint64]string, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64String checks which key-value pairs from one map[uint64]string are not in another map[uint64]string.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64String(Map1, Map2 map[uint64]string) (map[uint64]PairMismatch[string], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64String checks if all values of map[uint64]string are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64String(Map map[uint64]string) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Int compares two maps map[uint64]int.
+func AreEqualMapsUint64Int(Map1, Map2 map[uint64]int) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Int checks if an int (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Int(X int, Map map[uint64]int) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Int checks if any of the values of an int slice is a value of map[uint64]int.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Int(Slice []int, Map map[uint64]int) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Int checks if all values of an int slice are values of map[uint64]int.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Int(Slice []int, Map map[uint64]int) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Int checks which values of an int slice are values of map[uint64]int.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Int(Slice []int, Map map[uint64]int) (map[int][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Int checks which values of an int slice are not values of map[uint64]int.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Int(Slice []int, Map map[uint64]int) (map[int][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Int checks if all key-value pairs from one map[uint64]int are in another map[uint64]int.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Int(Map1, Map2 map[uint64]int) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Int checks if any key-value pair from one map[uint64]int is in another map[uint64]int.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Int(Map1, Map2 map[uint64]int) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Int checks which key-value pairs from one map[uint64]int are in another map[uint64]int.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Int(Map1, Map2 map[uint64]int) (map[uint64]int, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Int checks which key-value pairs from one map[uint64]int are not in another map[uint64]int.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Int(Map1, Map2 map[uint64]int) (map[uint64]PairMismatch[int], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Int checks if all values of map[uint64]int are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Int(Map map[uint64]int) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Float64 compares two maps map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsUint64Float64(Map1, Map2 map[uint64]float64, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapUint64Float64 checks if a float64 (X) is among the map's values.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Float64(X float64, Map map[uint64]float64, Epsilon float64) ([]uint64, bool) {
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
+
+// AnyValueInMapUint64Float64 checks if any of the values of a float64 slice is a value of map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Float64(Slice []float64, Map map[uint64]float64, Epsilon float64) bool {
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInMapUint64Float64 checks if all values of a float64 slice are values of map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Float64(Slice []float64, Map map[uint64]float64, Epsilon float64) bool {
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesInMapUint64Float64 checks which values of a float64 slice are values of map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Float64(Slice []float64, Map map[uint64]float64, Epsilon float64) (map[float64][]uint64, bool) {
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesNotInMapUint64Float64 checks which values of a float64 slice are not values of map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Float64(Slice []float64, Map map[uint64]float64, Epsilon float64) (map[float64][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllKeyValuePairsInMapUint64Float64 checks if all key-value pairs from one map[uint64]float64 are in another map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Float64(Map1, Map2 map[uint64]float64, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AnyKeyValuePairInMapUint64Float64 checks if any key-value pair from one map[uint64]float64 is in another map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Float64(Map1, Map2 map[uint64]float64, Epsilon float64) bool {
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsInMapUint64Float64 checks which key-value pairs from one map[uint64]float64 are in another map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Float64(Map1, Map2 map[uint64]float64, Epsilon float64) (map[uint64]float64, bool) {
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsNotInMapUint64Float64 checks which key-value pairs from one map[uint64]float64 are not in another map[uint64]float64.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Float64(Map1, Map2 map[uint64]float64, Epsilon float64) (map[uint64]PairMismatch[float64], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// IsUniqueMapUint64Float64 checks if all values of map[uint64]float64 are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Float64(Map map[uint64]float64, Epsilon float64) bool {
+	return IsUniqueMapFloat(Map, Epsilon)
+}
+
+// AreEqualMapsUint64Int8 compares two maps map[uint64]int8.
+func AreEqualMapsUint64Int8(Map1, Map2 map[uint64]int8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Int8 checks if an int8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Int8(X int8, Map map[uint64]int8) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Int8 checks if any of the values of an int8 slice is a value of map[uint64]int8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Int8(Slice []int8, Map map[uint64]int8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Int8 checks if all values of an int8 slice are values of map[uint64]int8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Int8(Slice []int8, Map map[uint64]int8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Int8 checks which values of an int8 slice are values of map[uint64]int8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Int8(Slice []int8, Map map[uint64]int8) (map[int8][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Int8 checks which values of an int8 slice are not values of map[uint64]int8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Int8(Slice []int8, Map map[uint64]int8) (map[int8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Int8 checks if all key-value pairs from one map[uint64]int8 are in another map[uint64]int8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Int8(Map1, Map2 map[uint64]int8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Int8 checks if any key-value pair from one map[uint64]int8 is in another map[uint64]int8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Int8(Map1, Map2 map[uint64]int8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Int8 checks which key-value pairs from one map[uint64]int8 are in another map[uint64]int8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Int8(Map1, Map2 map[uint64]int8) (map[uint64]int8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Int8 checks which key-value pairs from one map[uint64]int8 are not in another map[uint64]int8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Int8(Map1, Map2 map[uint64]int8) (map[uint64]PairMismatch[int8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Int8 checks if all values of map[uint64]int8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Int8(Map map[uint64]int8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Int16 compares two maps map[uint64]int16.
+func AreEqualMapsUint64Int16(Map1, Map2 map[uint64]int16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Int16 checks if an int16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Int16(X int16, Map map[uint64]int16) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Int16 checks if any of the values of an int16 slice is a value of map[uint64]int16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Int16(Slice []int16, Map map[uint64]int16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Int16 checks if all values of an int16 slice are values of map[uint64]int16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Int16(Slice []int16, Map map[uint64]int16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Int16 checks which values of an int16 slice are values of map[uint64]int16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Int16(Slice []int16, Map map[uint64]int16) (map[int16][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Int16 checks which values of an int16 slice are not values of map[uint64]int16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Int16(Slice []int16, Map map[uint64]int16) (map[int16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Int16 checks if all key-value pairs from one map[uint64]int16 are in another map[uint64]int16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Int16(Map1, Map2 map[uint64]int16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Int16 checks if any key-value pair from one map[uint64]int16 is in another map[uint64]int16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Int16(Map1, Map2 map[uint64]int16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Int16 checks which key-value pairs from one map[uint64]int16 are in another map[uint64]int16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Int16(Map1, Map2 map[uint64]int16) (map[uint64]int16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Int16 checks which key-value pairs from one map[uint64]int16 are not in another map[uint64]int16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Int16(Map1, Map2 map[uint64]int16) (map[uint64]PairMismatch[int16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Int16 checks if all values of map[uint64]int16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Int16(Map map[uint64]int16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Int32 compares two maps map[uint64]int32.
+func AreEqualMapsUint64Int32(Map1, Map2 map[uint64]int32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Int32 checks if an int32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Int32(X int32, Map map[uint64]int32) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Int32 checks if any of the values of an int32 slice is a value of map[uint64]int32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Int32(Slice []int32, Map map[uint64]int32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Int32 checks if all values of an int32 slice are values of map[uint64]int32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Int32(Slice []int32, Map map[uint64]int32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Int32 checks which values of an int32 slice are values of map[uint64]int32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Int32(Slice []int32, Map map[uint64]int32) (map[int32][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Int32 checks which values of an int32 slice are not values of map[uint64]int32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Int32(Slice []int32, Map map[uint64]int32) (map[int32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Int32 checks if all key-value pairs from one map[uint64]int32 are in another map[uint64]int32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Int32(Map1, Map2 map[uint64]int32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Int32 checks if any key-value pair from one map[uint64]int32 is in another map[uint64]int32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Int32(Map1, Map2 map[uint64]int32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Int32 checks which key-value pairs from one map[uint64]int32 are in another map[uint64]int32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Int32(Map1, Map2 map[uint64]int32) (map[uint64]int32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Int32 checks which key-value pairs from one map[uint64]int32 are not in another map[uint64]int32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Int32(Map1, Map2 map[uint64]int32) (map[uint64]PairMismatch[int32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Int32 checks if all values of map[uint64]int32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Int32(Map map[uint64]int32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Int64 compares two maps map[uint64]int64.
+func AreEqualMapsUint64Int64(Map1, Map2 map[uint64]int64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Int64 checks if an int64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Int64(X int64, Map map[uint64]int64) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Int64 checks if any of the values of an int64 slice is a value of map[uint64]int64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Int64(Slice []int64, Map map[uint64]int64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Int64 checks if all values of an int64 slice are values of map[uint64]int64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Int64(Slice []int64, Map map[uint64]int64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Int64 checks which values of an int64 slice are values of map[uint64]int64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Int64(Slice []int64, Map map[uint64]int64) (map[int64][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Int64 checks which values of an int64 slice are not values of map[uint64]int64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Int64(Slice []int64, Map map[uint64]int64) (map[int64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Int64 checks if all key-value pairs from one map[uint64]int64 are in another map[uint64]int64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Int64(Map1, Map2 map[uint64]int64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Int64 checks if any key-value pair from one map[uint64]int64 is in another map[uint64]int64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Int64(Map1, Map2 map[uint64]int64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Int64 checks which key-value pairs from one map[uint64]int64 are in another map[uint64]int64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Int64(Map1, Map2 map[uint64]int64) (map[uint64]int64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Int64 checks which key-value pairs from one map[uint64]int64 are not in another map[uint64]int64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Int64(Map1, Map2 map[uint64]int64) (map[uint64]PairMismatch[int64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Int64 checks if all values of map[uint64]int64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Int64(Map map[uint64]int64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Uint compares two maps map[uint64]uint.
+func AreEqualMapsUint64Uint(Map1, Map2 map[uint64]uint) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Uint checks if an uint (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Uint(X uint, Map map[uint64]uint) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Uint checks if any of the values of an uint slice is a value of map[uint64]uint.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Uint(Slice []uint, Map map[uint64]uint) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Uint checks if all values of an uint slice are values of map[uint64]uint.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Uint(Slice []uint, Map map[uint64]uint) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Uint checks which values of an uint slice are values of map[uint64]uint.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Uint(Slice []uint, Map map[uint64]uint) (map[uint][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Uint checks which values of an uint slice are not values of map[uint64]uint.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Uint(Slice []uint, Map map[uint64]uint) (map[uint][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Uint checks if all key-value pairs from one map[uint64]uint are in another map[uint64]uint.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Uint(Map1, Map2 map[uint64]uint) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Uint checks if any key-value pair from one map[uint64]uint is in another map[uint64]uint.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Uint(Map1, Map2 map[uint64]uint) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Uint checks which key-value pairs from one map[uint64]uint are in another map[uint64]uint.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Uint(Map1, Map2 map[uint64]uint) (map[uint64]uint, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Uint checks which key-value pairs from one map[uint64]uint are not in another map[uint64]uint.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Uint(Map1, Map2 map[uint64]uint) (map[uint64]PairMismatch[uint], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Uint checks if all values of map[uint64]uint are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Uint(Map map[uint64]uint) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Uint8 compares two maps map[uint64]uint8.
+func AreEqualMapsUint64Uint8(Map1, Map2 map[uint64]uint8) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Uint8 checks if an uint8 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Uint8(X uint8, Map map[uint64]uint8) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Uint8 checks if any of the values of an uint8 slice is a value of map[uint64]uint8.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Uint8(Slice []uint8, Map map[uint64]uint8) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Uint8 checks if all values of an uint8 slice are values of map[uint64]uint8.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Uint8(Slice []uint8, Map map[uint64]uint8) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Uint8 checks which values of an uint8 slice are values of map[uint64]uint8.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Uint8(Slice []uint8, Map map[uint64]uint8) (map[uint8][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Uint8 checks which values of an uint8 slice are not values of map[uint64]uint8.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Uint8(Slice []uint8, Map map[uint64]uint8) (map[uint8][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Uint8 checks if all key-value pairs from one map[uint64]uint8 are in another map[uint64]uint8.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Uint8(Map1, Map2 map[uint64]uint8) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Uint8 checks if any key-value pair from one map[uint64]uint8 is in another map[uint64]uint8.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Uint8(Map1, Map2 map[uint64]uint8) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Uint8 checks which key-value pairs from one map[uint64]uint8 are in another map[uint64]uint8.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Uint8(Map1, Map2 map[uint64]uint8) (map[uint64]uint8, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Uint8 checks which key-value pairs from one map[uint64]uint8 are not in another map[uint64]uint8.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Uint8(Map1, Map2 map[uint64]uint8) (map[uint64]PairMismatch[uint8], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Uint8 checks if all values of map[uint64]uint8 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Uint8(Map map[uint64]uint8) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Uint16 compares two maps map[uint64]uint16.
+func AreEqualMapsUint64Uint16(Map1, Map2 map[uint64]uint16) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Uint16 checks if an uint16 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Uint16(X uint16, Map map[uint64]uint16) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Uint16 checks if any of the values of an uint16 slice is a value of map[uint64]uint16.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Uint16(Slice []uint16, Map map[uint64]uint16) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Uint16 checks if all values of an uint16 slice are values of map[uint64]uint16.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Uint16(Slice []uint16, Map map[uint64]uint16) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Uint16 checks which values of an uint16 slice are values of map[uint64]uint16.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Uint16(Slice []uint16, Map map[uint64]uint16) (map[uint16][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Uint16 checks which values of an uint16 slice are not values of map[uint64]uint16.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Uint16(Slice []uint16, Map map[uint64]uint16) (map[uint16][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Uint16 checks if all key-value pairs from one map[uint64]uint16 are in another map[uint64]uint16.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Uint16(Map1, Map2 map[uint64]uint16) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Uint16 checks if any key-value pair from one map[uint64]uint16 is in another map[uint64]uint16.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Uint16(Map1, Map2 map[uint64]uint16) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Uint16 checks which key-value pairs from one map[uint64]uint16 are in another map[uint64]uint16.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Uint16(Map1, Map2 map[uint64]uint16) (map[uint64]uint16, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Uint16 checks which key-value pairs from one map[uint64]uint16 are not in another map[uint64]uint16.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Uint16(Map1, Map2 map[uint64]uint16) (map[uint64]PairMismatch[uint16], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Uint16 checks if all values of map[uint64]uint16 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Uint16(Map map[uint64]uint16) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Uint32 compares two maps map[uint64]uint32.
+func AreEqualMapsUint64Uint32(Map1, Map2 map[uint64]uint32) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Uint32 checks if an uint32 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Uint32(X uint32, Map map[uint64]uint32) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Uint32 checks if any of the values of an uint32 slice is a value of map[uint64]uint32.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Uint32(Slice []uint32, Map map[uint64]uint32) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Uint32 checks if all values of an uint32 slice are values of map[uint64]uint32.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Uint32(Slice []uint32, Map map[uint64]uint32) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Uint32 checks which values of an uint32 slice are values of map[uint64]uint32.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Uint32(Slice []uint32, Map map[uint64]uint32) (map[uint32][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Uint32 checks which values of an uint32 slice are not values of map[uint64]uint32.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Uint32(Slice []uint32, Map map[uint64]uint32) (map[uint32][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Uint32 checks if all key-value pairs from one map[uint64]uint32 are in another map[uint64]uint32.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Uint32(Map1, Map2 map[uint64]uint32) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Uint32 checks if any key-value pair from one map[uint64]uint32 is in another map[uint64]uint32.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Uint32(Map1, Map2 map[uint64]uint32) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Uint32 checks which key-value pairs from one map[uint64]uint32 are in another map[uint64]uint32.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Uint32(Map1, Map2 map[uint64]uint32) (map[uint64]uint32, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Uint32 checks which key-value pairs from one map[uint64]uint32 are not in another map[uint64]uint32.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Uint32(Map1, Map2 map[uint64]uint32) (map[uint64]PairMismatch[uint32], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Uint32 checks if all values of map[uint64]uint32 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Uint32(Map map[uint64]uint32) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Uint64 compares two maps map[uint64]uint64.
+func AreEqualMapsUint64Uint64(Map1, Map2 map[uint64]uint64) bool {
+	return AreEqualMaps(Map1, Map2)
+}
+
+// IsValueInMapUint64Uint64 checks if an uint64 (X) is among the map's values.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Uint64(X uint64, Map map[uint64]uint64) ([]uint64, bool) {
+	return IsValueInMapSorted(X, Map)
+}
+
+// AnyValueInMapUint64Uint64 checks if any of the values of an uint64 slice is a value of map[uint64]uint64.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Uint64(Slice []uint64, Map map[uint64]uint64) bool {
+	return AnyValueInMap(Slice, Map)
+}
+
+// AllValuesInMapUint64Uint64 checks if all values of an uint64 slice are values of map[uint64]uint64.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Uint64(Slice []uint64, Map map[uint64]uint64) bool {
+	return AllValuesInMap(Slice, Map)
+}
+
+// WhichValuesInMapUint64Uint64 checks which values of an uint64 slice are values of map[uint64]uint64.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Uint64(Slice []uint64, Map map[uint64]uint64) (map[uint64][]uint64, bool) {
+	return WhichValuesInMapSorted(Slice, Map)
+}
+
+// WhichValuesNotInMapUint64Uint64 checks which values of an uint64 slice are not values of map[uint64]uint64.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Uint64(Slice []uint64, Map map[uint64]uint64) (map[uint64][]int, bool) {
+	return WhichValuesNotInMap(Slice, Map)
+}
+
+// AllKeyValuePairsInMapUint64Uint64 checks if all key-value pairs from one map[uint64]uint64 are in another map[uint64]uint64.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Uint64(Map1, Map2 map[uint64]uint64) bool {
+	return AllKeyValuePairsInMap(Map1, Map2)
+}
+
+// AnyKeyValuePairInMapUint64Uint64 checks if any key-value pair from one map[uint64]uint64 is in another map[uint64]uint64.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Uint64(Map1, Map2 map[uint64]uint64) bool {
+	return AnyKeyValuePairInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsInMapUint64Uint64 checks which key-value pairs from one map[uint64]uint64 are in another map[uint64]uint64.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Uint64(Map1, Map2 map[uint64]uint64) (map[uint64]uint64, bool) {
+	return WhichKeyValuePairsInMap(Map1, Map2)
+}
+
+// WhichKeyValuePairsNotInMapUint64Uint64 checks which key-value pairs from one map[uint64]uint64 are not in another map[uint64]uint64.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Uint64(Map1, Map2 map[uint64]uint64) (map[uint64]PairMismatch[uint64], bool) {
+	return WhichKeyValuePairsNotInMap(Map1, Map2)
+}
+
+// IsUniqueMapUint64Uint64 checks if all values of map[uint64]uint64 are unique.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Uint64(Map map[uint64]uint64) bool {
+	return IsUniqueMap(Map)
+}
+
+// AreEqualMapsUint64Float32 compares two maps map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func AreEqualMapsUint64Float32(Map1, Map2 map[uint64]float32, Epsilon float64) bool {
+	return AreEqualMapsFloat(Map1, Map2, Epsilon)
+}
+
+// IsValueInMapUint64Float32 checks if a float32 (X) is among the map's values.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of slice providing the map's keys that have this value, and a bool value (true if the returned slice is not empty).
+// The keys are sorted in increasing order.
+func IsValueInMapUint64Float32(X float32, Map map[uint64]float32, Epsilon float64) ([]uint64, bool) {
+	return IsValueInMapSortedFloat(X, Map, Epsilon)
+}
+
+// AnyValueInMapUint64Float32 checks if any of the values of a float32 slice is a value of map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AnyValueInMapUint64Float32(Slice []float32, Map map[uint64]float32, Epsilon float64) bool {
+	return AnyValueInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllValuesInMapUint64Float32 checks if all values of a float32 slice are values of map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When either the slice or the map is empty, it returns false.
+func AllValuesInMapUint64Float32(Slice []float32, Map map[uint64]float32, Epsilon float64) bool {
+	return AllValuesInMapFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesInMapUint64Float32 checks which values of a float32 slice are values of map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with values from Slice as keys and slices of keys in the map that have these values,
+// and a boolean value (true if the returned map is not empty). The keys in the returned map's slices are sorted in increasing order.
+func WhichValuesInMapUint64Float32(Slice []float32, Map map[uint64]float32, Epsilon float64) (map[float32][]uint64, bool) {
+	return WhichValuesInMapSortedFloat(Slice, Map, Epsilon)
+}
+
+// WhichValuesNotInMapUint64Float32 checks which values of a float32 slice are not values of map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The function returns a tuple with a map with the missing values from Slice as keys and their indices from Slice as the map's values,
+// and a boolean value (true if the returned map is not empty, that is, if any value is missing).
+func WhichValuesNotInMapUint64Float32(Slice []float32, Map map[uint64]float32, Epsilon float64) (map[float32][]int, bool) {
+	return WhichValuesNotInMapFloat(Slice, Map, Epsilon)
+}
+
+// AllKeyValuePairsInMapUint64Float32 checks if all key-value pairs from one map[uint64]float32 are in another map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AllKeyValuePairsInMapUint64Float32(Map1, Map2 map[uint64]float32, Epsilon float64) bool {
+	return AllKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// AnyKeyValuePairInMapUint64Float32 checks if any key-value pair from one map[uint64]float32 is in another map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// When any of the maps is empty, the function returns false.
+func AnyKeyValuePairInMapUint64Float32(Map1, Map2 map[uint64]float32, Epsilon float64) bool {
+	return AnyKeyValuePairInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsInMapUint64Float32 checks which key-value pairs from one map[uint64]float32 are in another map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the found key-value pairs, and true if the map is not empty.
+func WhichKeyValuePairsInMapUint64Float32(Map1, Map2 map[uint64]float32, Epsilon float64) (map[uint64]float32, bool) {
+	return WhichKeyValuePairsInMapFloat(Map1, Map2, Epsilon)
+}
+
+// WhichKeyValuePairsNotInMapUint64Float32 checks which key-value pairs from one map[uint64]float32 are not in another map[uint64]float32.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Returns a tuple of a map with the missing key-value pairs, described as PairMismatch values, and true if the map is not empty.
+func WhichKeyValuePairsNotInMapUint64Float32(Map1, Map2 map[uint64]float32, Epsilon float64) (map[uint64]PairMismatch[float32], bool) {
+	return WhichKeyValuePairsNotInMapFloat(Map1, Map2, Epsilon)
+}
+
+// IsUniqueMapUint64Float32 checks if all values of map[uint64]float32 are unique.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapUint64Float32(Map map[uint64]float32, Epsilon float64) bool {
+	return IsUniqueMapFloat(Map, Epsilon)
+}
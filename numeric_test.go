@@ -0,0 +1,368 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInt8Slices(t *testing.T) {
+	Slice1 := []int8{3, 1, 3}
+	Slice2 := []int8{1, 2, 3, 1}
+	if !IsValueInInt8Slice(2, Slice2) || IsValueInInt8Slice(4, Slice2) {
+		t.Errorf("IsValueInInt8Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInInt8Slice(Slice1, Slice2) || AllValuesInInt8Slice([]int8{1, 4}, Slice2) {
+		t.Errorf("AllValuesInInt8Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInInt8Slice([]int8{4, 1}, Slice2) || AnyValueInInt8Slice([]int8{4, 5}, Slice2) {
+		t.Errorf("AnyValueInInt8Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInInt8Slice([]int8{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInInt8Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInInt8Slice([]int8{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInInt8Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesInt8(Slice1, []int8{3, 1, 3}) || AreEqualSlicesInt8(Slice1, []int8{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesInt8 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesInt8(Slice1, []int8{3, 3, 1}) || AreEqualSortedSlicesInt8(Slice1, []int8{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesInt8 should compare values ignoring ordering")
+	}
+	if IsUniqueInt8Slice(Slice1) || !IsUniqueInt8Slice(UniqueInt8Slice(Slice1)) || len(UniqueInt8Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueInt8Slice(%v) = %v, UniqueInt8Slice(%v) = %v", Slice1, IsUniqueInt8Slice(Slice1), Slice1, UniqueInt8Slice(Slice1))
+	}
+}
+
+func TestInt16Slices(t *testing.T) {
+	Slice1 := []int16{3, 1, 3}
+	Slice2 := []int16{1, 2, 3, 1}
+	if !IsValueInInt16Slice(2, Slice2) || IsValueInInt16Slice(4, Slice2) {
+		t.Errorf("IsValueInInt16Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInInt16Slice(Slice1, Slice2) || AllValuesInInt16Slice([]int16{1, 4}, Slice2) {
+		t.Errorf("AllValuesInInt16Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInInt16Slice([]int16{4, 1}, Slice2) || AnyValueInInt16Slice([]int16{4, 5}, Slice2) {
+		t.Errorf("AnyValueInInt16Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInInt16Slice([]int16{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInInt16Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInInt16Slice([]int16{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInInt16Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesInt16(Slice1, []int16{3, 1, 3}) || AreEqualSlicesInt16(Slice1, []int16{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesInt16 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesInt16(Slice1, []int16{3, 3, 1}) || AreEqualSortedSlicesInt16(Slice1, []int16{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesInt16 should compare values ignoring ordering")
+	}
+	if IsUniqueInt16Slice(Slice1) || !IsUniqueInt16Slice(UniqueInt16Slice(Slice1)) || len(UniqueInt16Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueInt16Slice(%v) = %v, UniqueInt16Slice(%v) = %v", Slice1, IsUniqueInt16Slice(Slice1), Slice1, UniqueInt16Slice(Slice1))
+	}
+}
+
+func TestInt32Slices(t *testing.T) {
+	Slice1 := []int32{3, 1, 3}
+	Slice2 := []int32{1, 2, 3, 1}
+	if !IsValueInInt32Slice(2, Slice2) || IsValueInInt32Slice(4, Slice2) {
+		t.Errorf("IsValueInInt32Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInInt32Slice(Slice1, Slice2) || AllValuesInInt32Slice([]int32{1, 4}, Slice2) {
+		t.Errorf("AllValuesInInt32Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInInt32Slice([]int32{4, 1}, Slice2) || AnyValueInInt32Slice([]int32{4, 5}, Slice2) {
+		t.Errorf("AnyValueInInt32Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInInt32Slice([]int32{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInInt32Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInInt32Slice([]int32{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInInt32Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesInt32(Slice1, []int32{3, 1, 3}) || AreEqualSlicesInt32(Slice1, []int32{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesInt32 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesInt32(Slice1, []int32{3, 3, 1}) || AreEqualSortedSlicesInt32(Slice1, []int32{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesInt32 should compare values ignoring ordering")
+	}
+	if IsUniqueInt32Slice(Slice1) || !IsUniqueInt32Slice(UniqueInt32Slice(Slice1)) || len(UniqueInt32Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueInt32Slice(%v) = %v, UniqueInt32Slice(%v) = %v", Slice1, IsUniqueInt32Slice(Slice1), Slice1, UniqueInt32Slice(Slice1))
+	}
+}
+
+func TestInt64Slices(t *testing.T) {
+	Slice1 := []int64{3, 1, 3}
+	Slice2 := []int64{1, 2, 3, 1}
+	if !IsValueInInt64Slice(2, Slice2) || IsValueInInt64Slice(4, Slice2) {
+		t.Errorf("IsValueInInt64Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInInt64Slice(Slice1, Slice2) || AllValuesInInt64Slice([]int64{1, 4}, Slice2) {
+		t.Errorf("AllValuesInInt64Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInInt64Slice([]int64{4, 1}, Slice2) || AnyValueInInt64Slice([]int64{4, 5}, Slice2) {
+		t.Errorf("AnyValueInInt64Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInInt64Slice([]int64{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInInt64Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInInt64Slice([]int64{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInInt64Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesInt64(Slice1, []int64{3, 1, 3}) || AreEqualSlicesInt64(Slice1, []int64{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesInt64 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesInt64(Slice1, []int64{3, 3, 1}) || AreEqualSortedSlicesInt64(Slice1, []int64{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesInt64 should compare values ignoring ordering")
+	}
+	if IsUniqueInt64Slice(Slice1) || !IsUniqueInt64Slice(UniqueInt64Slice(Slice1)) || len(UniqueInt64Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueInt64Slice(%v) = %v, UniqueInt64Slice(%v) = %v", Slice1, IsUniqueInt64Slice(Slice1), Slice1, UniqueInt64Slice(Slice1))
+	}
+}
+
+func TestUintSlices(t *testing.T) {
+	Slice1 := []uint{3, 1, 3}
+	Slice2 := []uint{1, 2, 3, 1}
+	if !IsValueInUintSlice(2, Slice2) || IsValueInUintSlice(4, Slice2) {
+		t.Errorf("IsValueInUintSlice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInUintSlice(Slice1, Slice2) || AllValuesInUintSlice([]uint{1, 4}, Slice2) {
+		t.Errorf("AllValuesInUintSlice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInUintSlice([]uint{4, 1}, Slice2) || AnyValueInUintSlice([]uint{4, 5}, Slice2) {
+		t.Errorf("AnyValueInUintSlice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInUintSlice([]uint{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInUintSlice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInUintSlice([]uint{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInUintSlice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesUint(Slice1, []uint{3, 1, 3}) || AreEqualSlicesUint(Slice1, []uint{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesUint should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesUint(Slice1, []uint{3, 3, 1}) || AreEqualSortedSlicesUint(Slice1, []uint{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesUint should compare values ignoring ordering")
+	}
+	if IsUniqueUintSlice(Slice1) || !IsUniqueUintSlice(UniqueUintSlice(Slice1)) || len(UniqueUintSlice(Slice1)) != 2 {
+		t.Errorf("IsUniqueUintSlice(%v) = %v, UniqueUintSlice(%v) = %v", Slice1, IsUniqueUintSlice(Slice1), Slice1, UniqueUintSlice(Slice1))
+	}
+}
+
+func TestUint8Slices(t *testing.T) {
+	Slice1 := []uint8{3, 1, 3}
+	Slice2 := []uint8{1, 2, 3, 1}
+	if !IsValueInUint8Slice(2, Slice2) || IsValueInUint8Slice(4, Slice2) {
+		t.Errorf("IsValueInUint8Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInUint8Slice(Slice1, Slice2) || AllValuesInUint8Slice([]uint8{1, 4}, Slice2) {
+		t.Errorf("AllValuesInUint8Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInUint8Slice([]uint8{4, 1}, Slice2) || AnyValueInUint8Slice([]uint8{4, 5}, Slice2) {
+		t.Errorf("AnyValueInUint8Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInUint8Slice([]uint8{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInUint8Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInUint8Slice([]uint8{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInUint8Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesUint8(Slice1, []uint8{3, 1, 3}) || AreEqualSlicesUint8(Slice1, []uint8{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesUint8 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesUint8(Slice1, []uint8{3, 3, 1}) || AreEqualSortedSlicesUint8(Slice1, []uint8{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesUint8 should compare values ignoring ordering")
+	}
+	if IsUniqueUint8Slice(Slice1) || !IsUniqueUint8Slice(UniqueUint8Slice(Slice1)) || len(UniqueUint8Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueUint8Slice(%v) = %v, UniqueUint8Slice(%v) = %v", Slice1, IsUniqueUint8Slice(Slice1), Slice1, UniqueUint8Slice(Slice1))
+	}
+}
+
+func TestUint16Slices(t *testing.T) {
+	Slice1 := []uint16{3, 1, 3}
+	Slice2 := []uint16{1, 2, 3, 1}
+	if !IsValueInUint16Slice(2, Slice2) || IsValueInUint16Slice(4, Slice2) {
+		t.Errorf("IsValueInUint16Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInUint16Slice(Slice1, Slice2) || AllValuesInUint16Slice([]uint16{1, 4}, Slice2) {
+		t.Errorf("AllValuesInUint16Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInUint16Slice([]uint16{4, 1}, Slice2) || AnyValueInUint16Slice([]uint16{4, 5}, Slice2) {
+		t.Errorf("AnyValueInUint16Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInUint16Slice([]uint16{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInUint16Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInUint16Slice([]uint16{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInUint16Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesUint16(Slice1, []uint16{3, 1, 3}) || AreEqualSlicesUint16(Slice1, []uint16{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesUint16 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesUint16(Slice1, []uint16{3, 3, 1}) || AreEqualSortedSlicesUint16(Slice1, []uint16{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesUint16 should compare values ignoring ordering")
+	}
+	if IsUniqueUint16Slice(Slice1) || !IsUniqueUint16Slice(UniqueUint16Slice(Slice1)) || len(UniqueUint16Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueUint16Slice(%v) = %v, UniqueUint16Slice(%v) = %v", Slice1, IsUniqueUint16Slice(Slice1), Slice1, UniqueUint16Slice(Slice1))
+	}
+}
+
+func TestUint32Slices(t *testing.T) {
+	Slice1 := []uint32{3, 1, 3}
+	Slice2 := []uint32{1, 2, 3, 1}
+	if !IsValueInUint32Slice(2, Slice2) || IsValueInUint32Slice(4, Slice2) {
+		t.Errorf("IsValueInUint32Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInUint32Slice(Slice1, Slice2) || AllValuesInUint32Slice([]uint32{1, 4}, Slice2) {
+		t.Errorf("AllValuesInUint32Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInUint32Slice([]uint32{4, 1}, Slice2) || AnyValueInUint32Slice([]uint32{4, 5}, Slice2) {
+		t.Errorf("AnyValueInUint32Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInUint32Slice([]uint32{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInUint32Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInUint32Slice([]uint32{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInUint32Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesUint32(Slice1, []uint32{3, 1, 3}) || AreEqualSlicesUint32(Slice1, []uint32{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesUint32 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesUint32(Slice1, []uint32{3, 3, 1}) || AreEqualSortedSlicesUint32(Slice1, []uint32{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesUint32 should compare values ignoring ordering")
+	}
+	if IsUniqueUint32Slice(Slice1) || !IsUniqueUint32Slice(UniqueUint32Slice(Slice1)) || len(UniqueUint32Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueUint32Slice(%v) = %v, UniqueUint32Slice(%v) = %v", Slice1, IsUniqueUint32Slice(Slice1), Slice1, UniqueUint32Slice(Slice1))
+	}
+}
+
+func TestUint64Slices(t *testing.T) {
+	Slice1 := []uint64{3, 1, 3}
+	Slice2 := []uint64{1, 2, 3, 1}
+	if !IsValueInUint64Slice(2, Slice2) || IsValueInUint64Slice(4, Slice2) {
+		t.Errorf("IsValueInUint64Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInUint64Slice(Slice1, Slice2) || AllValuesInUint64Slice([]uint64{1, 4}, Slice2) {
+		t.Errorf("AllValuesInUint64Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInUint64Slice([]uint64{4, 1}, Slice2) || AnyValueInUint64Slice([]uint64{4, 5}, Slice2) {
+		t.Errorf("AnyValueInUint64Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInUint64Slice([]uint64{1, 4}, Slice2); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInUint64Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInUint64Slice([]uint64{1, 4}, Slice2); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInUint64Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesUint64(Slice1, []uint64{3, 1, 3}) || AreEqualSlicesUint64(Slice1, []uint64{3, 3, 1}) {
+		t.Errorf("AreEqualSlicesUint64 should compare values and ordering")
+	}
+	if !AreEqualSortedSlicesUint64(Slice1, []uint64{3, 3, 1}) || AreEqualSortedSlicesUint64(Slice1, []uint64{3, 1, 1}) {
+		t.Errorf("AreEqualSortedSlicesUint64 should compare values ignoring ordering")
+	}
+	if IsUniqueUint64Slice(Slice1) || !IsUniqueUint64Slice(UniqueUint64Slice(Slice1)) || len(UniqueUint64Slice(Slice1)) != 2 {
+		t.Errorf("IsUniqueUint64Slice(%v) = %v, UniqueUint64Slice(%v) = %v", Slice1, IsUniqueUint64Slice(Slice1), Slice1, UniqueUint64Slice(Slice1))
+	}
+}
+
+func TestFloat32Slices(t *testing.T) {
+	Slice1 := []float32{3.001, 1, 3}
+	Slice2 := []float32{1, 2, 3, 1.001}
+	if !IsValueInFloat32Slice(2.001, Slice2, .01) || IsValueInFloat32Slice(2.1, Slice2, .01) {
+		t.Errorf("IsValueInFloat32Slice should check if the value is in %v", Slice2)
+	}
+	if !AllValuesInFloat32Slice(Slice1, Slice2, .01) || AllValuesInFloat32Slice(Slice1, Slice2, 0) {
+		t.Errorf("AllValuesInFloat32Slice should check if all values are in %v", Slice2)
+	}
+	if !AnyValueInFloat32Slice([]float32{4, 1}, Slice2, 0) || AnyValueInFloat32Slice([]float32{4, 5}, Slice2, .01) {
+		t.Errorf("AnyValueInFloat32Slice should check if any value is in %v", Slice2)
+	}
+	if which, ok := WhichValuesInFloat32Slice([]float32{1, 4}, Slice2, .01); !ok || len(which) != 1 || !AreEqualSlicesInt(which[1], []int{0, 3}) {
+		t.Errorf("WhichValuesInFloat32Slice([1 4], %v) = %v, %v; want map[1:[0 3]] true", Slice2, which, ok)
+	}
+	if missing, ok := WhichValuesNotInFloat32Slice([]float32{1, 4}, Slice2, .01); !ok || len(missing) != 1 || !AreEqualSlicesInt(missing[4], []int{1}) {
+		t.Errorf("WhichValuesNotInFloat32Slice([1 4], %v) = %v, %v; want map[4:[1]] true", Slice2, missing, ok)
+	}
+	if !AreEqualSlicesFloat32(Slice1, []float32{3, 1, 3}, .01) || AreEqualSlicesFloat32(Slice1, []float32{3, 1, 3}, 0) {
+		t.Errorf("AreEqualSlicesFloat32 should compare values using Epsilon")
+	}
+	if !AreEqualSortedSlicesFloat32(Slice1, []float32{3, 3, 1}, .01) || AreEqualSortedSlicesFloat32(Slice1, []float32{3, 1, 1}, .01) {
+		t.Errorf("AreEqualSortedSlicesFloat32 should compare values ignoring ordering")
+	}
+	if IsUniqueFloat32Slice(Slice1, .01) || !IsUniqueFloat32Slice(Slice1, 0) || len(UniqueFloat32Slice(Slice1, .01)) != 2 {
+		t.Errorf("IsUniqueFloat32Slice and UniqueFloat32Slice should use Epsilon")
+	}
+}
+
+func TestInt64KeyedMaps(t *testing.T) {
+	Map1 := map[int64]string{10000000000: "a", 2: "b"}
+	Map2 := map[int64]string{10000000000: "a", 2: "c", 3: "b"}
+	if AreEqualMapsInt64String(Map1, Map2) || !AreEqualMapsInt64String(Map1, map[int64]string{2: "b", 10000000000: "a"}) {
+		t.Errorf("AreEqualMapsInt64String should compare the maps")
+	}
+	if keys, ok := IsValueInMapInt64String("b", Map2); !ok || len(keys) != 1 || keys[0] != 3 {
+		t.Errorf("IsValueInMapInt64String(b, %v) = %v, %v; want [3] true", Map2, keys, ok)
+	}
+	if !AnyValueInMapInt64String([]string{"x", "a"}, Map1) || AllValuesInMapInt64String([]string{"x", "a"}, Map1) {
+		t.Errorf("AnyValueInMapInt64String and AllValuesInMapInt64String should check the map's values")
+	}
+	if which, ok := WhichValuesInMapInt64String([]string{"a", "b"}, Map2); !ok || len(which) != 2 || which["a"][0] != 10000000000 {
+		t.Errorf("WhichValuesInMapInt64String = %v, %v", which, ok)
+	}
+	if missing, ok := WhichValuesNotInMapInt64String([]string{"a", "z"}, Map1); !ok || !AreEqualSlicesInt(missing["z"], []int{1}) {
+		t.Errorf("WhichValuesNotInMapInt64String = %v, %v; want map[z:[1]] true", missing, ok)
+	}
+	if AllKeyValuePairsInMapInt64String(Map1, Map2) || !AnyKeyValuePairInMapInt64String(Map1, Map2) {
+		t.Errorf("AllKeyValuePairsInMapInt64String and AnyKeyValuePairInMapInt64String should check the key-value pairs")
+	}
+	if pairs, ok := WhichKeyValuePairsInMapInt64String(Map1, Map2); !ok || !AreEqualMapsInt64String(pairs, map[int64]string{10000000000: "a"}) {
+		t.Errorf("WhichKeyValuePairsInMapInt64String = %v, %v", pairs, ok)
+	}
+	if mismatches, ok := WhichKeyValuePairsNotInMapInt64String(Map1, Map2); !ok || mismatches[2].OtherValue != "c" {
+		t.Errorf("WhichKeyValuePairsNotInMapInt64String = %v, %v", mismatches, ok)
+	}
+	if !IsUniqueMapInt64String(Map1) || IsUniqueMapInt64Uint32(map[int64]uint32{1: 5, 2: 5}) {
+		t.Errorf("IsUniqueMapInt64String and IsUniqueMapInt64Uint32 should check the uniqueness of values")
+	}
+}
+
+func TestUint64KeyedMaps(t *testing.T) {
+	readings := map[uint64]float32{1: 20.5, 2: 21, 18446744073709551615: 19.75}
+	if !AreEqualMapsUint64Float32(readings, map[uint64]float32{1: 20.51, 2: 21, 18446744073709551615: 19.75}, .1) {
+		t.Errorf("AreEqualMapsUint64Float32 should use Epsilon")
+	}
+	if keys, ok := IsValueInMapUint64Float32(20, readings, 1); !ok || len(keys) != 3 || keys[2] != 18446744073709551615 {
+		t.Errorf("IsValueInMapUint64Float32(20, %v, 1) = %v, %v; want [1 2 18446744073709551615] true", readings, keys, ok)
+	}
+	if IsUniqueMapUint64Float32(readings, 1) || !IsUniqueMapUint64Float32(readings, .1) {
+		t.Errorf("IsUniqueMapUint64Float32 should use Epsilon")
+	}
+	counters := map[uint64]uint32{1: 7, 2: 8}
+	if !AllKeyValuePairsInMapUint64Uint32(counters, map[uint64]uint32{1: 7, 2: 8, 3: 9}) || AreEqualMapsUint64Uint32(counters, map[uint64]uint32{1: 7}) {
+		t.Errorf("AllKeyValuePairsInMapUint64Uint32 and AreEqualMapsUint64Uint32 should compare the maps")
+	}
+	if which, ok := WhichValuesInMapStringInt64([]int64{1 << 40}, map[string]int64{"b": 1 << 40, "a": 1 << 40}); !ok || !AreEqualSlicesString(which[1<<40], []string{"a", "b"}) {
+		t.Errorf("WhichValuesInMapStringInt64 = %v, %v; want sorted keys", which, ok)
+	}
+}
+
+func ExampleWhichValuesNotInInt64Slice() {
+	ids := []int64{10000000001, 10000000002, 10000000003}
+	known := []int64{10000000001, 10000000003}
+	fmt.Println(AllValuesInInt64Slice(ids, known))
+	fmt.Println(WhichValuesNotInInt64Slice(ids, known))
+	// Output:
+	// false
+	// map[10000000002:[1]] true
+}
+
+func ExampleAreEqualMapsUint64Float32() {
+	fmt.Println(AreEqualMapsUint64Float32(
+		map[uint64]float32{1: 20.5, 2: 21},
+		map[uint64]float32{1: 20.52, 2: 21},
+		.05,
+	))
+	// Output:
+	// true
+}
@@ -0,0 +1,272 @@
+package check
+
+// countTrue counts the true conditions.
+func countTrue(Conditions []bool) int {
+	count := 0
+	for _, condition := range Conditions {
+		if condition {
+			count++
+		}
+	}
+	return count
+}
+
+// countTrueInMap counts the true values of a map.
+func countTrueInMap[K comparable](Conditions map[K]bool) int {
+	count := 0
+	for _, condition := range Conditions {
+		if condition {
+			count++
+		}
+	}
+	return count
+}
+
+// None checks if none of the conditions is met. For no conditions, it returns true.
+func None(Conditions []bool) bool {
+	return !Any(Conditions)
+}
+
+// ExactlyOne checks if exactly one of the conditions is met, as for mutually exclusive options.
+func ExactlyOne(Conditions []bool) bool {
+	return ExactlyN(Conditions, 1)
+}
+
+// AtLeastN checks if at least N of the conditions are met, as for a quorum.
+func AtLeastN(Conditions []bool, N int) bool {
+	return countTrue(Conditions) >= N
+}
+
+// AtMostN checks if at most N of the conditions are met.
+func AtMostN(Conditions []bool, N int) bool {
+	return countTrue(Conditions) <= N
+}
+
+// ExactlyN checks if exactly N of the conditions are met.
+func ExactlyN(Conditions []bool, N int) bool {
+	return countTrue(Conditions) == N
+}
+
+// whichViolate returns the indices of the conditions that break a required range of met conditions:
+// the true ones when too many conditions are met, and the false ones when too few are met.
+func whichViolate(Conditions []bool, Min, Max int) ([]int, bool) {
+	count := countTrue(Conditions)
+	if count >= Min && count <= Max {
+		return []int{}, false
+	}
+	offending := count > Max
+	indices := make([]int, 0)
+	for i, condition := range Conditions {
+		if condition == offending {
+			indices = append(indices, i)
+		}
+	}
+	return indices, true
+}
+
+// WhichViolateNone checks which conditions break None, that is, which are met.
+// Returns a tuple of the indices of these conditions and true if None does not hold.
+func WhichViolateNone(Conditions []bool) ([]int, bool) {
+	return whichViolate(Conditions, 0, 0)
+}
+
+// WhichViolateExactlyOne checks which conditions break ExactlyOne: the met ones when more than one is met,
+// and the unmet ones when none is met. Returns a tuple of the indices of these conditions and true if ExactlyOne does not hold.
+func WhichViolateExactlyOne(Conditions []bool) ([]int, bool) {
+	return whichViolate(Conditions, 1, 1)
+}
+
+// WhichViolateAtLeastN checks which conditions break AtLeastN, that is, which are not met when fewer than N are met.
+// Returns a tuple of the indices of these conditions and true if AtLeastN does not hold.
+func WhichViolateAtLeastN(Conditions []bool, N int) ([]int, bool) {
+	return whichViolate(Conditions, N, len(Conditions))
+}
+
+// WhichViolateAtMostN checks which conditions break AtMostN, that is, which are met when more than N are met.
+// Returns a tuple of the indices of these conditions and true if AtMostN does not hold.
+func WhichViolateAtMostN(Conditions []bool, N int) ([]int, bool) {
+	return whichViolate(Conditions, 0, N)
+}
+
+// WhichViolateExactlyN checks which conditions break ExactlyN: the met ones when more than N are met,
+// and the unmet ones when fewer than N are met. Returns a tuple of the indices of these conditions and true if ExactlyN does not hold.
+func WhichViolateExactlyN(Conditions []bool, N int) ([]int, bool) {
+	return whichViolate(Conditions, N, N)
+}
+
+// NoneInMap checks if none of the map's values is true. It works with maps of any comparable key type.
+// For no conditions, it returns true.
+func NoneInMap[K comparable](Conditions map[K]bool) bool {
+	return !AnyInMap(Conditions)
+}
+
+// ExactlyOneInMap checks if exactly one of the map's values is true. It works with maps of any comparable key type.
+func ExactlyOneInMap[K comparable](Conditions map[K]bool) bool {
+	return ExactlyNInMap(Conditions, 1)
+}
+
+// AtLeastNInMap checks if at least N of the map's values are true. It works with maps of any comparable key type.
+func AtLeastNInMap[K comparable](Conditions map[K]bool, N int) bool {
+	return countTrueInMap(Conditions) >= N
+}
+
+// AtMostNInMap checks if at most N of the map's values are true. It works with maps of any comparable key type.
+func AtMostNInMap[K comparable](Conditions map[K]bool, N int) bool {
+	return countTrueInMap(Conditions) <= N
+}
+
+// ExactlyNInMap checks if exactly N of the map's values are true. It works with maps of any comparable key type.
+func ExactlyNInMap[K comparable](Conditions map[K]bool, N int) bool {
+	return countTrueInMap(Conditions) == N
+}
+
+// whichViolateInMap returns the sorted keys of the conditions that break a required range of true values; see whichViolate.
+func whichViolateInMap[K Ordered](Conditions map[K]bool, Min, Max int) ([]K, bool) {
+	count := countTrueInMap(Conditions)
+	if count >= Min && count <= Max {
+		return []K{}, false
+	}
+	offending := count > Max
+	keys := make([]K, 0)
+	for key, condition := range Conditions {
+		if condition == offending {
+			keys = append(keys, key)
+		}
+	}
+	return sortKeys(keys), true
+}
+
+// WhichViolateNoneInMap checks which keys break NoneInMap, that is, which have true values.
+// It works with maps of any ordered key type.
+// Returns a tuple of these keys, sorted in increasing order, and true if NoneInMap does not hold.
+func WhichViolateNoneInMap[K Ordered](Conditions map[K]bool) ([]K, bool) {
+	return whichViolateInMap(Conditions, 0, 0)
+}
+
+// WhichViolateExactlyOneInMap checks which keys break ExactlyOneInMap: the ones with true values when more than one is true,
+// and the ones with false values when none is true. It works with maps of any ordered key type.
+// Returns a tuple of these keys, sorted in increasing order, and true if ExactlyOneInMap does not hold.
+func WhichViolateExactlyOneInMap[K Ordered](Conditions map[K]bool) ([]K, bool) {
+	return whichViolateInMap(Conditions, 1, 1)
+}
+
+// WhichViolateAtLeastNInMap checks which keys break AtLeastNInMap, that is, which have false values when fewer than N are true.
+// It works with maps of any ordered key type.
+// Returns a tuple of these keys, sorted in increasing order, and true if AtLeastNInMap does not hold.
+func WhichViolateAtLeastNInMap[K Ordered](Conditions map[K]bool, N int) ([]K, bool) {
+	return whichViolateInMap(Conditions, N, len(Conditions))
+}
+
+// WhichViolateAtMostNInMap checks which keys break AtMostNInMap, that is, which have true values when more than N are true.
+// It works with maps of any ordered key type.
+// Returns a tuple of these keys, sorted in increasing order, and true if AtMostNInMap does not hold.
+func WhichViolateAtMostNInMap[K Ordered](Conditions map[K]bool, N int) ([]K, bool) {
+	return whichViolateInMap(Conditions, 0, N)
+}
+
+// WhichViolateExactlyNInMap checks which keys break ExactlyNInMap: the ones with true values when more than N are true,
+// and the ones with false values when fewer than N are true. It works with maps of any ordered key type.
+// Returns a tuple of these keys, sorted in increasing order, and true if ExactlyNInMap does not hold.
+func WhichViolateExactlyNInMap[K Ordered](Conditions map[K]bool, N int) ([]K, bool) {
+	return whichViolateInMap(Conditions, N, N)
+}
+
+// NoneInMapInt checks if none of the map's values is true. For no conditions, it returns true.
+func NoneInMapInt(Conditions map[int]bool) bool {
+	return NoneInMap(Conditions)
+}
+
+// ExactlyOneInMapInt checks if exactly one of the map's values is true.
+func ExactlyOneInMapInt(Conditions map[int]bool) bool {
+	return ExactlyOneInMap(Conditions)
+}
+
+// AtLeastNInMapInt checks if at least N of the map's values are true.
+func AtLeastNInMapInt(Conditions map[int]bool, N int) bool {
+	return AtLeastNInMap(Conditions, N)
+}
+
+// AtMostNInMapInt checks if at most N of the map's values are true.
+func AtMostNInMapInt(Conditions map[int]bool, N int) bool {
+	return AtMostNInMap(Conditions, N)
+}
+
+// ExactlyNInMapInt checks if exactly N of the map's values are true.
+func ExactlyNInMapInt(Conditions map[int]bool, N int) bool {
+	return ExactlyNInMap(Conditions, N)
+}
+
+// WhichViolateNoneInMapInt checks which keys break NoneInMapInt; see WhichViolateNoneInMap.
+func WhichViolateNoneInMapInt(Conditions map[int]bool) ([]int, bool) {
+	return WhichViolateNoneInMap(Conditions)
+}
+
+// WhichViolateExactlyOneInMapInt checks which keys break ExactlyOneInMapInt; see WhichViolateExactlyOneInMap.
+func WhichViolateExactlyOneInMapInt(Conditions map[int]bool) ([]int, bool) {
+	return WhichViolateExactlyOneInMap(Conditions)
+}
+
+// WhichViolateAtLeastNInMapInt checks which keys break AtLeastNInMapInt; see WhichViolateAtLeastNInMap.
+func WhichViolateAtLeastNInMapInt(Conditions map[int]bool, N int) ([]int, bool) {
+	return WhichViolateAtLeastNInMap(Conditions, N)
+}
+
+// WhichViolateAtMostNInMapInt checks which keys break AtMostNInMapInt; see WhichViolateAtMostNInMap.
+func WhichViolateAtMostNInMapInt(Conditions map[int]bool, N int) ([]int, bool) {
+	return WhichViolateAtMostNInMap(Conditions, N)
+}
+
+// WhichViolateExactlyNInMapInt checks which keys break ExactlyNInMapInt; see WhichViolateExactlyNInMap.
+func WhichViolateExactlyNInMapInt(Conditions map[int]bool, N int) ([]int, bool) {
+	return WhichViolateExactlyNInMap(Conditions, N)
+}
+
+// NoneInMapString checks if none of the map's values is true. For no conditions, it returns true.
+func NoneInMapString(Conditions map[string]bool) bool {
+	return NoneInMap(Conditions)
+}
+
+// ExactlyOneInMapString checks if exactly one of the map's values is true.
+func ExactlyOneInMapString(Conditions map[string]bool) bool {
+	return ExactlyOneInMap(Conditions)
+}
+
+// AtLeastNInMapString checks if at least N of the map's values are true.
+func AtLeastNInMapString(Conditions map[string]bool, N int) bool {
+	return AtLeastNInMap(Conditions, N)
+}
+
+// AtMostNInMapString checks if at most N of the map's values are true.
+func AtMostNInMapString(Conditions map[string]bool, N int) bool {
+	return AtMostNInMap(Conditions, N)
+}
+
+// ExactlyNInMapString checks if exactly N of the map's values are true.
+func ExactlyNInMapString(Conditions map[string]bool, N int) bool {
+	return ExactlyNInMap(Conditions, N)
+}
+
+// WhichViolateNoneInMapString checks which keys break NoneInMapString; see WhichViolateNoneInMap.
+func WhichViolateNoneInMapString(Conditions map[string]bool) ([]string, bool) {
+	return WhichViolateNoneInMap(Conditions)
+}
+
+// WhichViolateExactlyOneInMapString checks which keys break ExactlyOneInMapString; see WhichViolateExactlyOneInMap.
+func WhichViolateExactlyOneInMapString(Conditions map[string]bool) ([]string, bool) {
+	return WhichViolateExactlyOneInMap(Conditions)
+}
+
+// WhichViolateAtLeastNInMapString checks which keys break AtLeastNInMapString; see WhichViolateAtLeastNInMap.
+func WhichViolateAtLeastNInMapString(Conditions map[string]bool, N int) ([]string, bool) {
+	return WhichViolateAtLeastNInMap(Conditions, N)
+}
+
+// WhichViolateAtMostNInMapString checks which keys break AtMostNInMapString; see WhichViolateAtMostNInMap.
+func WhichViolateAtMostNInMapString(Conditions map[string]bool, N int) ([]string, bool) {
+	return WhichViolateAtMostNInMap(Conditions, N)
+}
+
+// WhichViolateExactlyNInMapString checks which keys break ExactlyNInMapString; see WhichViolateExactlyNInMap.
+func WhichViolateExactlyNInMapString(Conditions map[string]bool, N int) ([]string, bool) {
+	return WhichViolateExactlyNInMap(Conditions, N)
+}
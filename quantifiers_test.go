@@ -0,0 +1,115 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestQuantifiers(t *testing.T) {
+	tests := []struct {
+		conditions []bool
+		none       bool
+		exactlyOne bool
+		atLeast2   bool
+		atMost2    bool
+		exactly2   bool
+	}{
+		{[]bool{}, true, false, false, true, false},
+		{[]bool{false, false, false}, true, false, false, true, false},
+		{[]bool{false, true, false}, false, true, false, true, false},
+		{[]bool{true, true, false}, false, false, true, true, true},
+		{[]bool{true, true, true}, false, false, true, false, false},
+	}
+	for _, test := range tests {
+		if got := None(test.conditions); got != test.none {
+			t.Errorf("None(%v) = %v; want %v", test.conditions, got, test.none)
+		}
+		if got := ExactlyOne(test.conditions); got != test.exactlyOne {
+			t.Errorf("ExactlyOne(%v) = %v; want %v", test.conditions, got, test.exactlyOne)
+		}
+		if got := AtLeastN(test.conditions, 2); got != test.atLeast2 {
+			t.Errorf("AtLeastN(%v, 2) = %v; want %v", test.conditions, got, test.atLeast2)
+		}
+		if got := AtMostN(test.conditions, 2); got != test.atMost2 {
+			t.Errorf("AtMostN(%v, 2) = %v; want %v", test.conditions, got, test.atMost2)
+		}
+		if got := ExactlyN(test.conditions, 2); got != test.exactly2 {
+			t.Errorf("ExactlyN(%v, 2) = %v; want %v", test.conditions, got, test.exactly2)
+		}
+	}
+}
+
+func TestWhichViolate(t *testing.T) {
+	tests := []struct {
+		name       string
+		which      func([]bool) ([]int, bool)
+		conditions []bool
+		expected   []int
+		violated   bool
+	}{
+		{"None", WhichViolateNone, []bool{false, false}, []int{}, false},
+		{"None", WhichViolateNone, []bool{true, false, true}, []int{0, 2}, true},
+		{"ExactlyOne", WhichViolateExactlyOne, []bool{false, true}, []int{}, false},
+		{"ExactlyOne", WhichViolateExactlyOne, []bool{true, false, true}, []int{0, 2}, true},
+		{"ExactlyOne", WhichViolateExactlyOne, []bool{false, false}, []int{0, 1}, true},
+		{"ExactlyOne", WhichViolateExactlyOne, []bool{}, []int{}, true},
+		{"AtLeastN(2)", func(c []bool) ([]int, bool) { return WhichViolateAtLeastN(c, 2) }, []bool{true, false, true}, []int{}, false},
+		{"AtLeastN(2)", func(c []bool) ([]int, bool) { return WhichViolateAtLeastN(c, 2) }, []bool{true, false, false}, []int{1, 2}, true},
+		{"AtMostN(1)", func(c []bool) ([]int, bool) { return WhichViolateAtMostN(c, 1) }, []bool{false, true}, []int{}, false},
+		{"AtMostN(1)", func(c []bool) ([]int, bool) { return WhichViolateAtMostN(c, 1) }, []bool{true, false, true}, []int{0, 2}, true},
+		{"ExactlyN(2)", func(c []bool) ([]int, bool) { return WhichViolateExactlyN(c, 2) }, []bool{true, true, false}, []int{}, false},
+		{"ExactlyN(2)", func(c []bool) ([]int, bool) { return WhichViolateExactlyN(c, 2) }, []bool{true, false, false}, []int{1, 2}, true},
+		{"ExactlyN(2)", func(c []bool) ([]int, bool) { return WhichViolateExactlyN(c, 2) }, []bool{true, true, true}, []int{0, 1, 2}, true},
+	}
+	for _, test := range tests {
+		got, violated := test.which(test.conditions)
+		if !AreEqualSlicesInt(got, test.expected) || violated != test.violated {
+			t.Errorf("WhichViolate%v(%v) = %v, %v; want %v, %v", test.name, test.conditions, got, violated, test.expected, test.violated)
+		}
+	}
+}
+
+func TestQuantifiersInMap(t *testing.T) {
+	replicas := map[string]bool{"eu": true, "us": false, "asia": true}
+	if NoneInMapString(replicas) || ExactlyOneInMapString(replicas) || !AtLeastNInMapString(replicas, 2) ||
+		AtMostNInMapString(replicas, 1) || !ExactlyNInMapString(replicas, 2) {
+		t.Errorf("quantifiers give wrong results for %v", replicas)
+	}
+	if keys, violated := WhichViolateAtLeastNInMapString(replicas, 3); !violated || !AreEqualSlicesString(keys, []string{"us"}) {
+		t.Errorf("WhichViolateAtLeastNInMapString(%v, 3) = %v, %v; want [us] true", replicas, keys, violated)
+	}
+	if keys, violated := WhichViolateAtMostNInMapString(replicas, 1); !violated || !AreEqualSlicesString(keys, []string{"asia", "eu"}) {
+		t.Errorf("WhichViolateAtMostNInMapString(%v, 1) = %v, %v; want [asia eu] true", replicas, keys, violated)
+	}
+	if keys, violated := WhichViolateExactlyNInMapString(replicas, 2); violated || len(keys) != 0 {
+		t.Errorf("WhichViolateExactlyNInMapString(%v, 2) = %v, %v; want [] false", replicas, keys, violated)
+	}
+	options := map[int]bool{3: true, 1: true, 2: false}
+	if keys, violated := WhichViolateExactlyOneInMapInt(options); !violated || !AreEqualSlicesInt(keys, []int{1, 3}) {
+		t.Errorf("WhichViolateExactlyOneInMapInt(%v) = %v, %v; want [1 3] true", options, keys, violated)
+	}
+	if keys, violated := WhichViolateNoneInMapInt(options); !violated || !AreEqualSlicesInt(keys, []int{1, 3}) {
+		t.Errorf("WhichViolateNoneInMapInt(%v) = %v, %v; want [1 3] true", options, keys, violated)
+	}
+	if !NoneInMapInt(map[int]bool{}) || ExactlyOneInMapInt(map[int]bool{}) || !AtMostNInMapInt(options, 2) || ExactlyNInMapInt(options, 1) || AtLeastNInMapInt(options, 3) {
+		t.Errorf("quantifiers give wrong results for map[int]bool")
+	}
+}
+
+func ExampleWhichViolateExactlyOneInMap() {
+	flags := map[string]bool{"--json": true, "--yaml": true, "--text": false}
+	fmt.Println(ExactlyOneInMap(flags))
+	fmt.Println(WhichViolateExactlyOneInMap(flags))
+	// Output:
+	// false
+	// [--json --yaml] true
+}
+
+func ExampleAtLeastN() {
+	healthy := []bool{true, false, true}
+	fmt.Println(AtLeastN(healthy, 2))
+	fmt.Println(WhichViolateAtLeastN(healthy, 3))
+	// Output:
+	// true
+	// [1] true
+}
@@ -0,0 +1,278 @@
+package check
+
+import "sort"
+
+// SetOptions sets how the set operations (Union, Intersection, Difference and SymmetricDifference) treat slices.
+// The zero value treats the slices as sets and keeps the order of the values' first occurrence,
+// first in the first slice and then in the second one; thus, Union(Slice, nil, SetOptions{}) returns the same as UniqueSlice(Slice).
+type SetOptions struct {
+	// Sorted makes the result sorted in increasing order, instead of keeping the order of first occurrence.
+	Sorted bool
+	// Multiset makes the operations take into account how many times each value occurs.
+	// For instance, the union of []int{1, 1} and []int{1} is []int{1, 1}, and their difference is []int{1}.
+	Multiset bool
+}
+
+// Union returns the values that are in either of two slices of any ordered type.
+// With Options.Multiset, each value occurs as many times as it does in the slice in which it occurs more times.
+// NaN is not equal to itself, so each NaN element of both slices is kept, as in the other set operations.
+func Union[T Ordered](Slice1, Slice2 []T, Options SetOptions) []T {
+	values := append(append(make([]T, 0, len(Slice1)+len(Slice2)), Slice1...), Slice2...)
+	if !Options.Multiset {
+		return sortIf(UniqueSlice(values), Options.Sorted)
+	}
+	counts1, counts2 := countValues(Slice1), countValues(Slice2)
+	added := make(map[T]int)
+	union := make([]T, 0, len(values))
+	for _, value := range values {
+		if value != value {
+			union = append(union, value)
+			continue
+		}
+		if added[value] < counts1[value] || added[value] < counts2[value] {
+			added[value]++
+			union = append(union, value)
+		}
+	}
+	return sortIf(union, Options.Sorted)
+}
+
+// Intersection returns the values of the first slice that are also in the second slice; it works with slices of any ordered type.
+// With Options.Multiset, each value occurs as many times as it does in the slice in which it occurs fewer times.
+func Intersection[T Ordered](Slice1, Slice2 []T, Options SetOptions) []T {
+	intersection := make([]T, 0)
+	if !Options.Multiset {
+		idx := NewIndex(Slice2)
+		for _, value := range UniqueSlice(Slice1) {
+			if idx.IsValueIn(value) {
+				intersection = append(intersection, value)
+			}
+		}
+		return sortIf(intersection, Options.Sorted)
+	}
+	remaining := countValues(Slice2)
+	for _, value := range Slice1 {
+		if remaining[value] > 0 {
+			remaining[value]--
+			intersection = append(intersection, value)
+		}
+	}
+	return sortIf(intersection, Options.Sorted)
+}
+
+// Difference returns the values of the first slice that are not in the second slice; it works with slices of any ordered type.
+// With Options.Multiset, each occurrence of a value in the second slice removes one occurrence of it from the first slice.
+func Difference[T Ordered](Slice1, Slice2 []T, Options SetOptions) []T {
+	difference := make([]T, 0)
+	if !Options.Multiset {
+		idx := NewIndex(Slice2)
+		for _, value := range UniqueSlice(Slice1) {
+			if !idx.IsValueIn(value) {
+				difference = append(difference, value)
+			}
+		}
+		return sortIf(difference, Options.Sorted)
+	}
+	remaining := countValues(Slice2)
+	for _, value := range Slice1 {
+		if remaining[value] > 0 {
+			remaining[value]--
+			continue
+		}
+		difference = append(difference, value)
+	}
+	return sortIf(difference, Options.Sorted)
+}
+
+// SymmetricDifference returns the values that are in only one of two slices of any ordered type:
+// first those of the first slice and then those of the second one (unless Options.Sorted is used).
+// With Options.Multiset, it returns the multiset differences of the first slice and the second one, and vice versa.
+func SymmetricDifference[T Ordered](Slice1, Slice2 []T, Options SetOptions) []T {
+	unsorted := Options
+	unsorted.Sorted = false
+	difference := append(Difference(Slice1, Slice2, unsorted), Difference(Slice2, Slice1, unsorted)...)
+	return sortIf(difference, Options.Sorted)
+}
+
+// UnionTol returns the values that are in either of two slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Without Options.Multiset, the values are deduplicated as in UniqueSliceTol.
+// With Options.Multiset, it returns the first slice followed by the values of the second one that remain
+// after removing those matched by the first slice's values (see AllValuesInWithCountsTol).
+func UnionTol[T Float](Slice1, Slice2 []T, Tol Tolerance, Options SetOptions) []T {
+	if !Options.Multiset {
+		values := append(append(make([]T, 0, len(Slice1)+len(Slice2)), Slice1...), Slice2...)
+		return sortFloatsIf(UniqueSliceTol(values, Tol), Options.Sorted)
+	}
+	unsorted := Options
+	unsorted.Sorted = false
+	union := append(append(make([]T, 0, len(Slice1)), Slice1...), DifferenceTol(Slice2, Slice1, Tol, unsorted)...)
+	return sortFloatsIf(union, Options.Sorted)
+}
+
+// IntersectionTol returns the values of the first float slice that are also in the second slice; it works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Without Options.Multiset, the values are deduplicated as in UniqueSliceTol.
+// With Options.Multiset, each element of the second slice can match only one element of the first slice (see AllValuesInWithCountsTol).
+func IntersectionTol[T Float](Slice1, Slice2 []T, Tol Tolerance, Options SetOptions) []T {
+	intersection := make([]T, 0)
+	if !Options.Multiset {
+		for _, value := range UniqueSliceTol(Slice1, Tol) {
+			if IsValueInTol(value, Slice2, Tol) {
+				intersection = append(intersection, value)
+			}
+		}
+		return sortFloatsIf(intersection, Options.Sorted)
+	}
+	for i, matched := range matchFloats(Slice1, Slice2, Tol) {
+		if matched {
+			intersection = append(intersection, Slice1[i])
+		}
+	}
+	return sortFloatsIf(intersection, Options.Sorted)
+}
+
+// DifferenceTol returns the values of the first float slice that are not in the second slice; it works with slices of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Without Options.Multiset, the values are deduplicated as in UniqueSliceTol.
+// With Options.Multiset, each element of the second slice removes at most one element of the first slice (see AllValuesInWithCountsTol).
+func DifferenceTol[T Float](Slice1, Slice2 []T, Tol Tolerance, Options SetOptions) []T {
+	difference := make([]T, 0)
+	if !Options.Multiset {
+		for _, value := range UniqueSliceTol(Slice1, Tol) {
+			if !IsValueInTol(value, Slice2, Tol) {
+				difference = append(difference, value)
+			}
+		}
+		return sortFloatsIf(difference, Options.Sorted)
+	}
+	for i, matched := range matchFloats(Slice1, Slice2, Tol) {
+		if !matched {
+			difference = append(difference, Slice1[i])
+		}
+	}
+	return sortFloatsIf(difference, Options.Sorted)
+}
+
+// SymmetricDifferenceTol returns the values that are in only one of two slices of any float type:
+// first those of the first slice and then those of the second one (unless Options.Sorted is used).
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+func SymmetricDifferenceTol[T Float](Slice1, Slice2 []T, Tol Tolerance, Options SetOptions) []T {
+	unsorted := Options
+	unsorted.Sorted = false
+	difference := append(DifferenceTol(Slice1, Slice2, Tol, unsorted), DifferenceTol(Slice2, Slice1, Tol, unsorted)...)
+	return sortFloatsIf(difference, Options.Sorted)
+}
+
+// UnionFloat returns the values that are in either of two slices of any float type; see UnionTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func UnionFloat[T Float](Slice1, Slice2 []T, Epsilon float64, Options SetOptions) []T {
+	return UnionTol(Slice1, Slice2, AbsTolerance(Epsilon), Options)
+}
+
+// IntersectionFloat returns the values of the first float slice that are also in the second slice; see IntersectionTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func IntersectionFloat[T Float](Slice1, Slice2 []T, Epsilon float64, Options SetOptions) []T {
+	return IntersectionTol(Slice1, Slice2, AbsTolerance(Epsilon), Options)
+}
+
+// DifferenceFloat returns the values of the first float slice that are not in the second slice; see DifferenceTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func DifferenceFloat[T Float](Slice1, Slice2 []T, Epsilon float64, Options SetOptions) []T {
+	return DifferenceTol(Slice1, Slice2, AbsTolerance(Epsilon), Options)
+}
+
+// SymmetricDifferenceFloat returns the values that are in only one of two float slices; see SymmetricDifferenceTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func SymmetricDifferenceFloat[T Float](Slice1, Slice2 []T, Epsilon float64, Options SetOptions) []T {
+	return SymmetricDifferenceTol(Slice1, Slice2, AbsTolerance(Epsilon), Options)
+}
+
+// UnionIntSlice returns the values that are in either of two int slices; see Union.
+func UnionIntSlice(Slice1, Slice2 []int, Options SetOptions) []int {
+	return Union(Slice1, Slice2, Options)
+}
+
+// UnionStringSlice returns the values that are in either of two string slices; see Union.
+func UnionStringSlice(Slice1, Slice2 []string, Options SetOptions) []string {
+	return Union(Slice1, Slice2, Options)
+}
+
+// UnionFloat64Slice returns the values that are in either of two float64 slices; see UnionTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func UnionFloat64Slice(Slice1, Slice2 []float64, Epsilon float64, Options SetOptions) []float64 {
+	return UnionFloat(Slice1, Slice2, Epsilon, Options)
+}
+
+// IntersectionIntSlice returns the values of the first int slice that are also in the second slice; see Intersection.
+func IntersectionIntSlice(Slice1, Slice2 []int, Options SetOptions) []int {
+	return Intersection(Slice1, Slice2, Options)
+}
+
+// IntersectionStringSlice returns the values of the first string slice that are also in the second slice; see Intersection.
+func IntersectionStringSlice(Slice1, Slice2 []string, Options SetOptions) []string {
+	return Intersection(Slice1, Slice2, Options)
+}
+
+// IntersectionFloat64Slice returns the values of the first float64 slice that are also in the second slice; see IntersectionTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func IntersectionFloat64Slice(Slice1, Slice2 []float64, Epsilon float64, Options SetOptions) []float64 {
+	return IntersectionFloat(Slice1, Slice2, Epsilon, Options)
+}
+
+// DifferenceIntSlice returns the values of the first int slice that are not in the second slice; see Difference.
+func DifferenceIntSlice(Slice1, Slice2 []int, Options SetOptions) []int {
+	return Difference(Slice1, Slice2, Options)
+}
+
+// DifferenceStringSlice returns the values of the first string slice that are not in the second slice; see Difference.
+func DifferenceStringSlice(Slice1, Slice2 []string, Options SetOptions) []string {
+	return Difference(Slice1, Slice2, Options)
+}
+
+// DifferenceFloat64Slice returns the values of the first float64 slice that are not in the second slice; see DifferenceTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func DifferenceFloat64Slice(Slice1, Slice2 []float64, Epsilon float64, Options SetOptions) []float64 {
+	return DifferenceFloat(Slice1, Slice2, Epsilon, Options)
+}
+
+// SymmetricDifferenceIntSlice returns the values that are in only one of two int slices; see SymmetricDifference.
+func SymmetricDifferenceIntSlice(Slice1, Slice2 []int, Options SetOptions) []int {
+	return SymmetricDifference(Slice1, Slice2, Options)
+}
+
+// SymmetricDifferenceStringSlice returns the values that are in only one of two string slices; see SymmetricDifference.
+func SymmetricDifferenceStringSlice(Slice1, Slice2 []string, Options SetOptions) []string {
+	return SymmetricDifference(Slice1, Slice2, Options)
+}
+
+// SymmetricDifferenceFloat64Slice returns the values that are in only one of two float64 slices; see SymmetricDifferenceTol.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func SymmetricDifferenceFloat64Slice(Slice1, Slice2 []float64, Epsilon float64, Options SetOptions) []float64 {
+	return SymmetricDifferenceFloat(Slice1, Slice2, Epsilon, Options)
+}
+
+// countValues counts the occurrences of each value of a slice.
+func countValues[T comparable](Slice []T) map[T]int {
+	counts := make(map[T]int, len(Slice))
+	for _, value := range Slice {
+		counts[value]++
+	}
+	return counts
+}
+
+// sortIf sorts a slice in place, if Sorted is true, and returns it.
+func sortIf[T Ordered](Slice []T, Sorted bool) []T {
+	if Sorted {
+		sort.Slice(Slice, func(i, j int) bool { return less(Slice[i], Slice[j]) })
+	}
+	return Slice
+}
+
+// sortFloatsIf sorts a float slice in place, if Sorted is true, and returns it.
+func sortFloatsIf[T Float](Slice []T, Sorted bool) []T {
+	if Sorted {
+		sort.Slice(Slice, func(i, j int) bool { return lessFloat(Slice[i], Slice[j]) })
+	}
+	return Slice
+}
@@ -0,0 +1,143 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSetOperationsIntSlice(t *testing.T) {
+	slice1, slice2 := []int{3, 1, 1, 2, 3, 3}, []int{4, 3, 3, 5, 4}
+	tests := []struct {
+		name     string
+		function func([]int, []int, SetOptions) []int
+		options  SetOptions
+		expected []int
+	}{
+		{"UnionIntSlice", UnionIntSlice, SetOptions{}, []int{3, 1, 2, 4, 5}},
+		{"UnionIntSlice", UnionIntSlice, SetOptions{Sorted: true}, []int{1, 2, 3, 4, 5}},
+		{"UnionIntSlice", UnionIntSlice, SetOptions{Multiset: true}, []int{3, 1, 1, 2, 3, 3, 4, 5, 4}},
+		{"UnionIntSlice", UnionIntSlice, SetOptions{Multiset: true, Sorted: true}, []int{1, 1, 2, 3, 3, 3, 4, 4, 5}},
+		{"IntersectionIntSlice", IntersectionIntSlice, SetOptions{}, []int{3}},
+		{"IntersectionIntSlice", IntersectionIntSlice, SetOptions{Multiset: true}, []int{3, 3}},
+		{"DifferenceIntSlice", DifferenceIntSlice, SetOptions{}, []int{1, 2}},
+		{"DifferenceIntSlice", DifferenceIntSlice, SetOptions{Multiset: true}, []int{1, 1, 2, 3}},
+		{"DifferenceIntSlice", DifferenceIntSlice, SetOptions{Multiset: true, Sorted: true}, []int{1, 1, 2, 3}},
+		{"SymmetricDifferenceIntSlice", SymmetricDifferenceIntSlice, SetOptions{}, []int{1, 2, 4, 5}},
+		{"SymmetricDifferenceIntSlice", SymmetricDifferenceIntSlice, SetOptions{Multiset: true}, []int{1, 1, 2, 3, 4, 5, 4}},
+		{"SymmetricDifferenceIntSlice", SymmetricDifferenceIntSlice, SetOptions{Multiset: true, Sorted: true}, []int{1, 1, 2, 3, 4, 4, 5}},
+	}
+	for _, test := range tests {
+		actual := test.function(slice1, slice2, test.options)
+		if !AreEqualSlicesInt(actual, test.expected) {
+			t.Errorf("%s(%v, %v, %+v) = %v; want %v", test.name, slice1, slice2, test.options, actual, test.expected)
+		}
+	}
+	if !AreEqualSlicesInt(slice1, []int{3, 1, 1, 2, 3, 3}) || !AreEqualSlicesInt(slice2, []int{4, 3, 3, 5, 4}) {
+		t.Errorf("set operations should not modify the slices")
+	}
+}
+
+func TestSetOperationsEmptySlices(t *testing.T) {
+	for _, options := range []SetOptions{{}, {Sorted: true}, {Multiset: true}} {
+		if len(UnionStringSlice(nil, nil, options)) != 0 ||
+			len(IntersectionStringSlice([]string{"a"}, nil, options)) != 0 ||
+			len(DifferenceStringSlice(nil, []string{"a"}, options)) != 0 ||
+			len(SymmetricDifferenceStringSlice(nil, nil, options)) != 0 {
+			t.Errorf("set operations on empty slices should return empty slices (%+v)", options)
+		}
+		if !AreEqualSlicesString(DifferenceStringSlice([]string{"a"}, nil, options), []string{"a"}) {
+			t.Errorf("DifferenceStringSlice([a], [], %+v) should be [a]", options)
+		}
+	}
+}
+
+func TestUnionIsConsistentWithUniqueSlice(t *testing.T) {
+	slices := [][]string{{}, {"a"}, {"b", "a", "b", "c", "a"}}
+	for _, slice := range slices {
+		if !AreEqualSlicesString(UnionStringSlice(slice, nil, SetOptions{}), UniqueStringSlice(slice)) {
+			t.Errorf("UnionStringSlice(%v, []) should be %v", slice, UniqueStringSlice(slice))
+		}
+	}
+	floats := []float64{.1, .2, .105, .3}
+	if !AreEqualSlicesFloat64(UnionFloat64Slice(floats, nil, .01, SetOptions{}), UniqueFloat64Slice(floats, .01), 0) {
+		t.Errorf("UnionFloat64Slice(%v, [], .01) should be %v", floats, UniqueFloat64Slice(floats, .01))
+	}
+}
+
+func TestSetOperationsFloat64Slice(t *testing.T) {
+	slice1, slice2 := []float64{.3, .1, .105, .2}, []float64{.4, .295, .308}
+	tests := []struct {
+		name     string
+		function func([]float64, []float64, float64, SetOptions) []float64
+		options  SetOptions
+		expected []float64
+	}{
+		{"UnionFloat64Slice", UnionFloat64Slice, SetOptions{}, []float64{.3, .1, .2, .4}},
+		{"UnionFloat64Slice", UnionFloat64Slice, SetOptions{Sorted: true}, []float64{.1, .2, .3, .4}},
+		{"UnionFloat64Slice", UnionFloat64Slice, SetOptions{Multiset: true}, []float64{.3, .1, .105, .2, .4, .308}},
+		{"IntersectionFloat64Slice", IntersectionFloat64Slice, SetOptions{}, []float64{.3}},
+		{"IntersectionFloat64Slice", IntersectionFloat64Slice, SetOptions{Multiset: true}, []float64{.3}},
+		{"DifferenceFloat64Slice", DifferenceFloat64Slice, SetOptions{}, []float64{.1, .2}},
+		{"DifferenceFloat64Slice", DifferenceFloat64Slice, SetOptions{Multiset: true}, []float64{.1, .105, .2}},
+		{"SymmetricDifferenceFloat64Slice", SymmetricDifferenceFloat64Slice, SetOptions{}, []float64{.1, .2, .4}},
+		{"SymmetricDifferenceFloat64Slice", SymmetricDifferenceFloat64Slice, SetOptions{Multiset: true, Sorted: true}, []float64{.1, .105, .2, .308, .4}},
+	}
+	for _, test := range tests {
+		actual := test.function(slice1, slice2, .01, test.options)
+		if !AreEqualSlicesFloat64(actual, test.expected, 0) {
+			t.Errorf("%s(%v, %v, .01, %+v) = %v; want %v", test.name, slice1, slice2, test.options, actual, test.expected)
+		}
+	}
+}
+
+func TestUnionNaN(t *testing.T) {
+	nan := math.NaN()
+	for _, options := range []SetOptions{{}, {Multiset: true}, {Multiset: true, Sorted: true}} {
+		actual := Union([]float64{nan, 1}, []float64{nan}, options)
+		nans := 0
+		for _, value := range actual {
+			if math.IsNaN(value) {
+				nans++
+			}
+		}
+		if len(actual) != 3 || nans != 2 {
+			t.Errorf("Union([NaN 1], [NaN], %+v) = %v; want both NaNs and 1", options, actual)
+		}
+	}
+}
+
+func TestSetOperationsTol(t *testing.T) {
+	nan := math.NaN()
+	tol := AbsTolerance(0).WithPolicy(MissingDataPolicy)
+	if actual := IntersectionTol([]float64{nan, 1, nan}, []float64{nan}, tol, SetOptions{}); len(actual) != 1 || !math.IsNaN(actual[0]) {
+		t.Errorf("IntersectionTol([NaN 1 NaN], [NaN]) with MissingDataPolicy = %v; want [NaN]", actual)
+	}
+	if actual := DifferenceTol([]float64{nan, 1}, []float64{nan}, AbsTolerance(0), SetOptions{}); len(actual) != 2 {
+		t.Errorf("DifferenceTol([NaN 1], [NaN]) = %v; want [NaN 1]", actual)
+	}
+	if actual := UnionTol([]float64{100, 200}, []float64{101, 300}, RelTolerance(.02), SetOptions{}); !AreEqualSlicesFloat64(actual, []float64{100, 200, 300}, 0) {
+		t.Errorf("UnionTol([100 200], [101 300], rtol = 0.02) = %v; want [100 200 300]", actual)
+	}
+	if actual := UnionFloat([]float32{1, 2}, []float32{2.05}, .1, SetOptions{Sorted: true}); !AreEqualSlicesFloat(actual, []float32{1, 2}, 0) {
+		t.Errorf("UnionFloat([1 2], [2.05], .1) = %v; want [1 2]", actual)
+	}
+}
+
+func ExampleUnionIntSlice() {
+	fmt.Println(UnionIntSlice([]int{3, 1, 3}, []int{2, 1}, SetOptions{}))
+	fmt.Println(UnionIntSlice([]int{3, 1, 3}, []int{2, 1}, SetOptions{Sorted: true}))
+	fmt.Println(UnionIntSlice([]int{3, 1, 3}, []int{2, 1}, SetOptions{Multiset: true}))
+	// Output:
+	// [3 1 2]
+	// [1 2 3]
+	// [3 1 3 2]
+}
+
+func ExampleDifferenceStringSlice() {
+	fmt.Println(DifferenceStringSlice([]string{"a", "b", "a"}, []string{"a"}, SetOptions{}))
+	fmt.Println(DifferenceStringSlice([]string{"a", "b", "a"}, []string{"a"}, SetOptions{Multiset: true}))
+	// Output:
+	// [b]
+	// [b a]
+}
@@ -0,0 +1,20 @@
+package check
+
+import "sort"
+
+// SortedKeys returns the keys of a map of any ordered key type, sorted in increasing order.
+// Use it to range over the maps returned by the package's functions (like WhichValuesIn and WhichKeyValuePairsInMap)
+// in a deterministic order, e.g., in golden-output tests.
+func SortedKeys[K Ordered, V any](Map map[K]V) []K {
+	keys := make([]K, 0, len(Map))
+	for key := range Map {
+		keys = append(keys, key)
+	}
+	return sortKeys(keys)
+}
+
+// sortKeys sorts keys in place, in increasing order, and returns them.
+func sortKeys[K Ordered](Keys []K) []K {
+	sort.Slice(Keys, func(i, j int) bool { return less(Keys[i], Keys[j]) })
+	return Keys
+}
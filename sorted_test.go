@@ -0,0 +1,120 @@
+package check
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+	if keys := SortedKeys(map[string]int{"b": 1, "c": 2, "a": 3}); !AreEqualSlicesString(keys, []string{"a", "b", "c"}) {
+		t.Errorf("SortedKeys(map[a:3 b:1 c:2]) = %v; want [a b c]", keys)
+	}
+	if keys := SortedKeys(map[int]bool{}); len(keys) != 0 {
+		t.Errorf("SortedKeys(map[]) = %v; want []", keys)
+	}
+}
+
+func ExampleSortedKeys() {
+	values, _ := WhichValuesInIntSlice([]int{3, 1, 2}, []int{1, 2, 3, 1})
+	for _, value := range SortedKeys(values) {
+		fmt.Println(value, values[value])
+	}
+	// Output:
+	// 1 [0 3]
+	// 2 [1]
+	// 3 [2]
+}
+
+func manyKeys[V any](Value V) map[int]V {
+	Map := make(map[int]V)
+	for i := 0; i < 50; i++ {
+		Map[i*7%50] = Value
+	}
+	return Map
+}
+
+func isIncreasing[K Ordered](Keys []K) bool {
+	for i := 1; i < len(Keys); i++ {
+		if !less(Keys[i-1], Keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFunctionsReturningMapKeysSortThem(t *testing.T) {
+	for round := 0; round < 10; round++ {
+		if keys, _ := WhichInMapInt(manyKeys(true)); len(keys) != 50 || !isIncreasing(keys) {
+			t.Fatalf("WhichInMapInt() = %v; want sorted keys", keys)
+		}
+		if keys, _ := WhichInMapString(map[string]bool{"c": true, "a": true, "b": false, "d": true}); !AreEqualSlicesString(keys, []string{"a", "c", "d"}) {
+			t.Fatalf("WhichInMapString() = %v; want [a c d]", keys)
+		}
+		if keys, _ := IsValueInMapIntInt(1, manyKeys(1)); len(keys) != 50 || !isIncreasing(keys) {
+			t.Fatalf("IsValueInMapIntInt() = %v; want sorted keys", keys)
+		}
+		if keys, _ := IsValueInMapIntString("a", manyKeys("a")); len(keys) != 50 || !isIncreasing(keys) {
+			t.Fatalf("IsValueInMapIntString() = %v; want sorted keys", keys)
+		}
+		if keys, _ := IsValueInMapIntFloat64(.1, manyKeys(.1), 0); len(keys) != 50 || !isIncreasing(keys) {
+			t.Fatalf("IsValueInMapIntFloat64() = %v; want sorted keys", keys)
+		}
+		if keys, _ := IsValueInMapStringString("x", map[string]string{"b": "x", "a": "x", "c": "x"}); !AreEqualSlicesString(keys, []string{"a", "b", "c"}) {
+			t.Fatalf("IsValueInMapStringString() = %v; want [a b c]", keys)
+		}
+		if keys, _ := IsValueInMapStringInt(1, map[string]int{"b": 1, "a": 1, "c": 2}); !AreEqualSlicesString(keys, []string{"a", "b"}) {
+			t.Fatalf("IsValueInMapStringInt() = %v; want [a b]", keys)
+		}
+		if keys, _ := IsValueInMapStringFloat64(1, map[string]float64{"b": 1, "a": 1.01}, .1); !AreEqualSlicesString(keys, []string{"a", "b"}) {
+			t.Fatalf("IsValueInMapStringFloat64() = %v; want [a b]", keys)
+		}
+		values, _ := WhichValuesInMapIntInt([]int{1}, manyKeys(1))
+		if len(values[1]) != 50 || !isIncreasing(values[1]) {
+			t.Fatalf("WhichValuesInMapIntInt() = %v; want sorted keys", values)
+		}
+		floatValues, _ := WhichValuesInMapIntFloat64([]float64{1}, manyKeys(1.0), 0)
+		if len(floatValues[1]) != 50 || !isIncreasing(floatValues[1]) {
+			t.Fatalf("WhichValuesInMapIntFloat64() = %v; want sorted keys", floatValues)
+		}
+		stringValues, _ := WhichValuesInMapIntString([]string{"a"}, manyKeys("a"))
+		if len(stringValues["a"]) != 50 || !isIncreasing(stringValues["a"]) {
+			t.Fatalf("WhichValuesInMapIntString() = %v; want sorted keys", stringValues)
+		}
+		stringKeys, _ := WhichValuesInMapStringInt([]int{1}, map[string]int{"b": 1, "a": 1})
+		if !AreEqualSlicesString(stringKeys[1], []string{"a", "b"}) {
+			t.Fatalf("WhichValuesInMapStringInt() = %v; want map[1:[a b]]", stringKeys)
+		}
+		stringKeys2, _ := WhichValuesInMapStringString([]string{"x"}, map[string]string{"b": "x", "a": "x"})
+		if !AreEqualSlicesString(stringKeys2["x"], []string{"a", "b"}) {
+			t.Fatalf("WhichValuesInMapStringString() = %v; want map[x:[a b]]", stringKeys2)
+		}
+		stringKeys3, _ := WhichValuesInMapStringFloat64([]float64{1}, map[string]float64{"b": 1, "a": 1}, 0)
+		if !AreEqualSlicesString(stringKeys3[1], []string{"a", "b"}) {
+			t.Fatalf("WhichValuesInMapStringFloat64() = %v; want map[1:[a b]]", stringKeys3)
+		}
+	}
+}
+
+func TestSortedGenericVariants(t *testing.T) {
+	if keys, ok := WhichInMapSorted(map[float64]bool{2: true, 1: true, 3: false}); !ok || !AreEqualSlicesFloat(keys, []float64{1, 2}, 0) {
+		t.Errorf("WhichInMapSorted() = %v, %v; want [1 2], true", keys, ok)
+	}
+	if keys, ok := IsValueInMapSortedTol(1.0, map[string]float32{"b": 1, "a": 1.01}, RelTolerance(.1)); !ok || !AreEqualSlicesString(keys, []string{"a", "b"}) {
+		t.Errorf("IsValueInMapSortedTol() = %v, %v; want [a b], true", keys, ok)
+	}
+	if values, ok := WhichValuesInMapSortedTol([]float32{1}, map[string]float32{"b": 1, "a": 1.01}, RelTolerance(.1)); !ok || !AreEqualSlicesString(values[1], []string{"a", "b"}) {
+		t.Errorf("WhichValuesInMapSortedTol() = %v, %v; want map[1:[a b]], true", values, ok)
+	}
+}
+
+func ExampleWhichInMapSorted() {
+	fmt.Println(WhichInMapSorted(map[string]bool{"c": true, "a": true, "b": false, "d": true}))
+	// Output:
+	// [a c d] true
+}
+
+func ExampleIsValueInMapSorted() {
+	fmt.Println(IsValueInMapSorted(1, map[string]int{"c": 1, "a": 1, "b": 2}))
+	// Output:
+	// [a c] true
+}
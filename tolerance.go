@@ -0,0 +1,169 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// Tolerance sets how close two floats need to be to be considered equal.
+// Two floats X and Y are equal when
+//
+//	|X - Y| <= Abs + Rel*|Y|
+//
+// (as in numpy's isclose) or when there are at most ULPs representable floats between them.
+// Note that with a relative tolerance, the comparison is not symmetric, as Y is treated as the reference value.
+//
+// The Policy field sets how NaN, infinities and signed zeros are compared; see FloatPolicy.
+//
+// The zero value means exact equality. Use AbsTolerance, RelTolerance, CombinedTolerance and ULPTolerance to create a Tolerance,
+// and WithPolicy to change its policy.
+// Functions accepting a Tolerance have the Tol suffix, like IsValueInTol and AreEqualSlicesTol.
+type Tolerance struct {
+	Abs    float64
+	Rel    float64
+	ULPs   uint64
+	Policy FloatPolicy
+}
+
+// FloatPolicy sets how special float values are compared. The zero value keeps the package's default behavior,
+// which is also that of the functions taking the Epsilon parameter:
+// NaN is not equal to anything (including NaN), an infinity is not equal to anything (including the same infinity),
+// and -0 is equal to +0.
+type FloatPolicy struct {
+	// NaNEqualsNaN makes NaN equal to NaN (but still not equal to any other value).
+	NaNEqualsNaN bool
+	// InfEqualsInf makes +Inf equal to +Inf and -Inf equal to -Inf (but still not equal to any other value).
+	InfEqualsInf bool
+	// SignedZeros makes -0 and +0 different values.
+	SignedZeros bool
+}
+
+// MissingDataPolicy treats NaN as missing data, so that two NaN values are equal;
+// it also makes infinities of the same sign equal.
+var MissingDataPolicy = FloatPolicy{NaNEqualsNaN: true, InfEqualsInf: true}
+
+// WithPolicy returns a copy of the tolerance using a different policy for special float values.
+func (Tol Tolerance) WithPolicy(Policy FloatPolicy) Tolerance {
+	Tol.Policy = Policy
+	return Tol
+}
+
+// AbsTolerance returns a Tolerance with an absolute tolerance: two floats are equal when their absolute difference
+// is less than or equal to Epsilon. This is what the Epsilon parameter means in all functions that take it.
+func AbsTolerance(Epsilon float64) Tolerance {
+	return Tolerance{Abs: Epsilon}
+}
+
+// RelTolerance returns a Tolerance with a relative tolerance: X and Y are equal when |X - Y| <= Rel*|Y|.
+func RelTolerance(Rel float64) Tolerance {
+	return Tolerance{Rel: Rel}
+}
+
+// CombinedTolerance returns a Tolerance with both absolute and relative tolerance: X and Y are equal when
+// |X - Y| <= Abs + Rel*|Y|, as in numpy's isclose.
+func CombinedTolerance(Abs, Rel float64) Tolerance {
+	return Tolerance{Abs: Abs, Rel: Rel}
+}
+
+// ULPTolerance returns a Tolerance comparing floats by their distance in units in the last place (ULPs):
+// two floats are equal when there are at most ULPs representable floats between them.
+// The distance is measured for the floats' own type, so for float32 values it is counted in float32 ULPs.
+func ULPTolerance(ULPs uint64) Tolerance {
+	return Tolerance{ULPs: ULPs}
+}
+
+// Equal checks if two float64 values are equal within the tolerance.
+func (Tol Tolerance) Equal(X, Y float64) bool {
+	return isWithinTolerance(X, Y, Tol)
+}
+
+// String describes the tolerance, e.g., "epsilon = 0.1" for an absolute one.
+func (Tol Tolerance) String() string {
+	parts := make([]string, 0, 3)
+	switch {
+	case Tol.Abs != 0 && Tol.Rel == 0:
+		parts = append(parts, fmt.Sprintf("epsilon = %v", Tol.Abs))
+	case Tol.Abs != 0:
+		parts = append(parts, fmt.Sprintf("atol = %v", Tol.Abs), fmt.Sprintf("rtol = %v", Tol.Rel))
+	case Tol.Rel != 0:
+		parts = append(parts, fmt.Sprintf("rtol = %v", Tol.Rel))
+	}
+	if Tol.ULPs != 0 {
+		parts = append(parts, fmt.Sprintf("ulps = %v", Tol.ULPs))
+	}
+	if len(parts) == 0 {
+		return "epsilon = 0"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// isWithinTolerance checks if two floats are equal within the tolerance, following its policy for special values.
+func isWithinTolerance[T Float](X, Y T, Tol Tolerance) bool {
+	x, y := float64(X), float64(Y)
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return Tol.Policy.NaNEqualsNaN && math.IsNaN(x) && math.IsNaN(y)
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		return Tol.Policy.InfEqualsInf && x == y
+	case x == 0 && y == 0:
+		return !Tol.Policy.SignedZeros || math.Signbit(x) == math.Signbit(y)
+	}
+	if math.Abs(x-y) <= Tol.Abs+Tol.Rel*math.Abs(y) {
+		return true
+	}
+	return Tol.ULPs != 0 && ulpDistance(X, Y) <= Tol.ULPs
+}
+
+// lessFloat reports whether X should be sorted before Y. Like less, it puts NaN values first;
+// in addition, it puts -0 before +0, so that sorted slices can be compared with signed zeros.
+func lessFloat[T Float](X, Y T) bool {
+	if X == 0 && Y == 0 {
+		return math.Signbit(float64(X)) && !math.Signbit(float64(Y))
+	}
+	return less(X, Y)
+}
+
+// sortedFloats returns a copy of a float slice sorted in increasing order (see lessFloat).
+func sortedFloats[T Float](Slice []T) []T {
+	sorted := make([]T, len(Slice))
+	copy(sorted, Slice)
+	sort.Slice(sorted, func(i, j int) bool { return lessFloat(sorted[i], sorted[j]) })
+	return sorted
+}
+
+// ulpDistance returns the number of representable floats of type T between X and Y.
+func ulpDistance[T Float](X, Y T) uint64 {
+	var x, y int64
+	if unsafe.Sizeof(X) == 4 {
+		x, y = orderedBits32(float32(X)), orderedBits32(float32(Y))
+	} else {
+		x, y = orderedBits64(float64(X)), orderedBits64(float64(Y))
+	}
+	if x > y {
+		return uint64(x) - uint64(y)
+	}
+	return uint64(y) - uint64(x)
+}
+
+// orderedBits64 maps a float64 onto an int64 so that consecutive floats are mapped onto consecutive integers
+// (and both zeros onto 0).
+func orderedBits64(X float64) int64 {
+	bits := int64(math.Float64bits(X))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+	return bits
+}
+
+// orderedBits32 maps a float32 onto an int64 so that consecutive floats are mapped onto consecutive integers
+// (and both zeros onto 0).
+func orderedBits32(X float32) int64 {
+	bits := int32(math.Float32bits(X))
+	if bits < 0 {
+		return int64(math.MinInt32) - int64(bits)
+	}
+	return int64(bits)
+}
@@ -0,0 +1,226 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestToleranceEqual(t *testing.T) {
+	tests := []struct {
+		tol      Tolerance
+		x, y     float64
+		expected bool
+	}{
+		{Tolerance{}, 1, 1, true},
+		{Tolerance{}, 1, 1.0000001, false},
+		{AbsTolerance(.1), 1, 1.05, true},
+		{AbsTolerance(.1), 1, 1.2, false},
+		{AbsTolerance(.1), 1e10, 1e10 + 1, false},
+		{RelTolerance(1e-6), 1e10, 1e10 + 1, true},
+		{RelTolerance(1e-6), 1e-10, 1.1e-10, false},
+		{RelTolerance(.1), 0, 1e-300, false},
+		{CombinedTolerance(1e-8, 1e-6), 0, 1e-9, true},
+		{CombinedTolerance(1e-8, 1e-6), 1e10, 1e10 + 1, true},
+		{CombinedTolerance(1e-8, 1e-6), 1, 1.1, false},
+		{ULPTolerance(1), 1, math.Nextafter(1, 2), true},
+		{ULPTolerance(1), 1, math.Nextafter(math.Nextafter(1, 2), 2), false},
+		{ULPTolerance(2), 1, math.Nextafter(math.Nextafter(1, 2), 2), true},
+		{ULPTolerance(2), math.Nextafter(0, 1), math.Nextafter(0, -1), true},
+		{ULPTolerance(1), math.Copysign(0, -1), 0, true},
+		{ULPTolerance(math.MaxUint64), math.NaN(), math.NaN(), false},
+		{ULPTolerance(math.MaxUint64), math.Inf(1), math.MaxFloat64, false},
+		{AbsTolerance(1), math.NaN(), 1, false},
+	}
+	for _, test := range tests {
+		if actual := test.tol.Equal(test.x, test.y); actual != test.expected {
+			t.Errorf("%+v.Equal(%v, %v) = %v; want %v", test.tol, test.x, test.y, actual, test.expected)
+		}
+	}
+}
+
+func TestULPToleranceFloat32(t *testing.T) {
+	x := float32(1)
+	y := math.Nextafter32(x, 2)
+	if !IsValueInTol(x, []float32{y}, ULPTolerance(1)) {
+		t.Errorf("%v and %v should be 1 float32 ULP apart", x, y)
+	}
+	if IsValueInTol(float64(x), []float64{float64(y)}, ULPTolerance(1)) {
+		t.Errorf("%v and %v should be more than 1 float64 ULP apart", x, y)
+	}
+}
+
+func TestToleranceString(t *testing.T) {
+	tests := []struct {
+		tol      Tolerance
+		expected string
+	}{
+		{Tolerance{}, "epsilon = 0"},
+		{AbsTolerance(.1), "epsilon = 0.1"},
+		{RelTolerance(.01), "rtol = 0.01"},
+		{CombinedTolerance(.1, .01), "atol = 0.1, rtol = 0.01"},
+		{ULPTolerance(4), "ulps = 4"},
+	}
+	for _, test := range tests {
+		if actual := test.tol.String(); actual != test.expected {
+			t.Errorf("%+v.String() = %q; want %q", test.tol, actual, test.expected)
+		}
+	}
+}
+
+func TestTolVariants(t *testing.T) {
+	slice1 := []float64{1e-9, 1, 1e9}
+	slice2 := []float64{1.0000001e-9, 1.0000001, 1.0000001e9}
+	if AreEqualSlicesFloat64(slice1, slice2, 1e-6) {
+		t.Errorf("AreEqualSlicesFloat64(%v, %v, 1e-6) should be false", slice1, slice2)
+	}
+	if !AreEqualSlicesTol(slice1, slice2, RelTolerance(1e-6)) {
+		t.Errorf("AreEqualSlicesTol(%v, %v, RelTolerance(1e-6)) should be true", slice1, slice2)
+	}
+	if AreEqualSlicesTol(slice1, slice2, RelTolerance(1e-8)) {
+		t.Errorf("AreEqualSlicesTol(%v, %v, RelTolerance(1e-8)) should be false", slice1, slice2)
+	}
+	if !AreEqualSortedSlicesTol([]float64{1e9, 1}, []float64{1.0000001, 1.0000001e9}, RelTolerance(1e-6)) {
+		t.Errorf("AreEqualSortedSlicesTol should be true")
+	}
+	if !AreEqualMapsTol(map[string]float64{"a": 1e9}, map[string]float64{"a": 1e9 + 10}, RelTolerance(1e-6)) {
+		t.Errorf("AreEqualMapsTol should be true")
+	}
+	if keys, ok := IsValueInMapTol(1e9, map[int]float64{1: 1e9 + 10, 2: 1}, RelTolerance(1e-6)); !ok || !AreEqualSlices(keys, []int{1}) {
+		t.Errorf("IsValueInMapTol returned %v, %v; want [1], true", keys, ok)
+	}
+	if IsUniqueSliceTol(slice1, CombinedTolerance(1e-8, 1e-6)) != true {
+		t.Errorf("IsUniqueSliceTol(%v) should be true", slice1)
+	}
+	if IsUniqueSliceTol(append(slice1, slice2...), CombinedTolerance(1e-8, 1e-6)) != false {
+		t.Errorf("IsUniqueSliceTol should be false")
+	}
+	if actual := UniqueSliceTol(append(slice1, slice2...), CombinedTolerance(1e-8, 1e-6)); !AreEqualSlices(actual, slice1) {
+		t.Errorf("UniqueSliceTol returned %v; want %v", actual, slice1)
+	}
+	if !AllKeyValuePairsInMapTol(map[int]float64{1: 1e9}, map[int]float64{1: 1e9 + 10, 2: 0}, RelTolerance(1e-6)) {
+		t.Errorf("AllKeyValuePairsInMapTol should be true")
+	}
+}
+
+func ExampleRelTolerance() {
+	fmt.Println(AreEqualSlicesFloat64([]float64{1e-9, 1e9}, []float64{1.1e-9, 1e9 + 10}, 1e-6))
+	fmt.Println(AreEqualSlicesTol([]float64{1e-9, 1e9}, []float64{1.1e-9, 1e9 + 10}, RelTolerance(1e-6)))
+	fmt.Println(AreEqualSlicesTol([]float64{1e-9, 1e9}, []float64{1.1e-9, 1e9 + 10}, CombinedTolerance(1e-6, 1e-6)))
+	// Output:
+	// false
+	// false
+	// true
+}
+
+func ExampleDiffSlicesTol() {
+	fmt.Println(DiffSlicesTol([]float64{1, 100}, []float64{1.5, 101}, RelTolerance(.1)))
+	// Output:
+	// changed index 0: 1 != 1.5 (|difference| = 0.5; rtol = 0.1)
+}
+
+func TestFloatPolicy(t *testing.T) {
+	nan, inf, negZero := math.NaN(), math.Inf(1), math.Copysign(0, -1)
+	tests := []struct {
+		policy   FloatPolicy
+		x, y     float64
+		expected bool
+	}{
+		{FloatPolicy{}, nan, nan, false},
+		{FloatPolicy{NaNEqualsNaN: true}, nan, nan, true},
+		{FloatPolicy{NaNEqualsNaN: true}, nan, 1, false},
+		{FloatPolicy{}, inf, inf, false},
+		{FloatPolicy{InfEqualsInf: true}, inf, inf, true},
+		{FloatPolicy{InfEqualsInf: true}, -inf, -inf, true},
+		{FloatPolicy{InfEqualsInf: true}, inf, -inf, false},
+		{FloatPolicy{InfEqualsInf: true}, inf, math.MaxFloat64, false},
+		{FloatPolicy{}, negZero, 0, true},
+		{FloatPolicy{SignedZeros: true}, negZero, 0, false},
+		{FloatPolicy{SignedZeros: true}, negZero, negZero, true},
+		{MissingDataPolicy, nan, nan, true},
+		{MissingDataPolicy, inf, inf, true},
+	}
+	for _, test := range tests {
+		tol := AbsTolerance(.1).WithPolicy(test.policy)
+		if actual := tol.Equal(test.x, test.y); actual != test.expected {
+			t.Errorf("Equal(%v, %v) with policy %+v = %v; want %v", test.x, test.y, test.policy, actual, test.expected)
+		}
+	}
+}
+
+func TestFloatPolicyAcrossFunctions(t *testing.T) {
+	nan := math.NaN()
+	tol := AbsTolerance(.01).WithPolicy(MissingDataPolicy)
+	slice := []float64{1, nan, 3}
+
+	if AreEqualSlicesFloat64(slice, []float64{1, nan, 3}, .01) {
+		t.Errorf("AreEqualSlicesFloat64 should treat NaN as different from NaN")
+	}
+	if !AreEqualSlicesTol(slice, []float64{1, nan, 3}, tol) {
+		t.Errorf("AreEqualSlicesTol should treat NaN as equal to NaN")
+	}
+	if !AreEqualSortedSlicesTol([]float64{3, nan, 1}, []float64{nan, 1, 3}, tol) {
+		t.Errorf("AreEqualSortedSlicesTol should treat NaN as equal to NaN")
+	}
+	if !AreEqualMapsTol(map[string]float64{"a": nan}, map[string]float64{"a": nan}, tol) {
+		t.Errorf("AreEqualMapsTol should treat NaN as equal to NaN")
+	}
+	if !IsValueInTol(nan, slice, tol) || IsValueInFloat64Slice(nan, slice, .01) {
+		t.Errorf("IsValueInTol should find NaN only with NaNEqualsNaN")
+	}
+	if keys, ok := IsValueInMapTol(nan, map[int]float64{1: nan, 2: 2}, tol); !ok || !AreEqualSlices(keys, []int{1}) {
+		t.Errorf("IsValueInMapTol returned %v, %v; want [1], true", keys, ok)
+	}
+	if IsUniqueSliceTol([]float64{nan, 1, nan}, tol) || !IsUniqueFloat64Slice([]float64{nan, 1, nan}, .01) {
+		t.Errorf("IsUniqueSliceTol should consider NaN values duplicated only with NaNEqualsNaN")
+	}
+	if actual := UniqueSliceTol([]float64{nan, 1, nan}, tol); len(actual) != 2 || !math.IsNaN(actual[0]) || actual[1] != 1 {
+		t.Errorf("UniqueSliceTol returned %v; want [NaN 1]", actual)
+	}
+	if IsUniqueMapTol(map[string]float64{"a": nan, "b": nan}, tol) {
+		t.Errorf("IsUniqueMapTol should consider NaN values duplicated")
+	}
+	values, ok := WhichValuesInTol([]float64{nan, nan, 5}, []float64{nan, 1, nan}, tol)
+	if !ok || len(values) != 1 {
+		t.Errorf("WhichValuesInTol returned %v, %v; want one NaN key", values, ok)
+	}
+	for _, indices := range values {
+		if !AreEqualSlices(indices, []int{0, 2}) {
+			t.Errorf("WhichValuesInTol returned %v; want NaN at [0 2]", values)
+		}
+	}
+	inMap, ok := WhichValuesInMapTol([]float64{nan, nan}, map[string]float64{"a": nan}, tol)
+	if !ok || len(inMap) != 1 {
+		t.Errorf("WhichValuesInMapTol returned %v, %v; want one NaN key", inMap, ok)
+	}
+	if !AllKeyValuePairsInMapTol(map[string]float64{"a": nan}, map[string]float64{"a": nan, "b": 1}, tol) {
+		t.Errorf("AllKeyValuePairsInMapTol should treat NaN as equal to NaN")
+	}
+	if d := DiffSlicesTol(slice, []float64{1, nan, 3}, tol); !d.IsEmpty() {
+		t.Errorf("DiffSlicesTol returned %v; want no differences", d)
+	}
+}
+
+func TestSignedZerosPolicy(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	tol := Tolerance{Policy: FloatPolicy{SignedZeros: true}}
+	if AreEqualSlicesTol([]float64{negZero}, []float64{0}, tol) {
+		t.Errorf("AreEqualSlicesTol should consider -0 and +0 different")
+	}
+	if !AreEqualSortedSlicesTol([]float64{0, negZero, 1}, []float64{negZero, 1, 0}, tol) {
+		t.Errorf("AreEqualSortedSlicesTol should match -0 with -0 and +0 with +0")
+	}
+	if !IsUniqueSliceTol([]float64{negZero, 0}, tol) || IsUniqueFloat64Slice([]float64{negZero, 0}, 0) {
+		t.Errorf("-0 and +0 should be unique only with SignedZeros")
+	}
+}
+
+func ExampleFloatPolicy() {
+	got := []float64{1, math.NaN(), 3}
+	want := []float64{1, math.NaN(), 3}
+	fmt.Println(AreEqualSlicesFloat64(got, want, 1e-9))
+	fmt.Println(AreEqualSlicesTol(got, want, AbsTolerance(1e-9).WithPolicy(MissingDataPolicy)))
+	// Output:
+	// false
+	// true
+}
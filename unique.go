@@ -2,64 +2,249 @@ package check
 
 import (
 	"math"
+	"sort"
 )
 
-// IsUniqueFloat64Slice checks if all elements of a float64 slice are unique (so the slice does not contain duplicated elements).
-// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// IsUniqueSlice checks if all elements of a slice of any comparable type are unique (so the slice does not contain duplicated elements).
 // If the slice has no elements, the function returns true (since it does not contain duplicated elements).
-func IsUniqueFloat64Slice(Slice []float64, Epsilon float64) bool {
-	if len(Slice) == 0 {
-		return true
+// The function uses a map, so it runs in linear time.
+func IsUniqueSlice[T comparable](Slice []T) bool {
+	seen := make(map[T]struct{}, len(Slice))
+	for _, value := range Slice {
+		if _, ok := seen[value]; ok {
+			return false
+		}
+		seen[value] = struct{}{}
 	}
-	for i, value := range Slice {
-		for j := i + 1; j < len(Slice); j++ {
-			if math.Abs(value-Slice[j]) <= Epsilon {
-				return false
-			}
+	return true
+}
+
+// IsUniqueSliceTol checks if all elements of a slice of any float type are unique (so the slice does not contain duplicated elements).
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Two floats are duplicates when either of them is within the tolerance of the other.
+// If the slice has no elements, the function returns true (since it does not contain duplicated elements).
+// The function sorts a copy of the slice and compares neighboring values, so it runs in O(n log n) time.
+func IsUniqueSliceTol[T Float](Slice []T, Tol Tolerance) bool {
+	sorted := sortedFloats(Slice)
+	for i := 1; i < len(sorted); i++ {
+		if areDuplicates(sorted[i-1], sorted[i], Tol) {
+			return false
 		}
 	}
 	return true
 }
 
-// IsUniqueIntSlice checks if all elements of an int slice are unique (so the slice does not contain duplicated elements).
+// IsUniqueSliceFloat checks if all elements of a slice of any float type are unique (so the slice does not contain duplicated elements).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
 // If the slice has no elements, the function returns true (since it does not contain duplicated elements).
-func IsUniqueIntSlice(Slice []int) bool {
+func IsUniqueSliceFloat[T Float](Slice []T, Epsilon float64) bool {
+	return IsUniqueSliceTol(Slice, AbsTolerance(Epsilon))
+}
+
+// UniqueSlice returns a slice with unique elements of a slice of any comparable type.
+// The elements keep the order of their first occurrence in the slice.
+// If the slice has no elements, the function returns an empty slice.
+// The function uses a map, so it runs in linear time.
+func UniqueSlice[T comparable](Slice []T) []T {
+	unique := make([]T, 0, len(Slice))
+	seen := make(map[T]struct{}, len(Slice))
+	for _, value := range Slice {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// UniqueSliceTol returns a slice with unique elements of a slice of any float type.
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// The elements keep the order of their first occurrence in the slice.
+// Two floats are duplicates when either of them is within the tolerance of the other,
+// and a value is dropped when it is a duplicate of a value that has already been kept.
+// If the slice has no elements, the function returns an empty slice.
+// The function runs in O(n log n) time, except for relative tolerances of 1 or more, for which it runs in O(n²) time.
+func UniqueSliceTol[T Float](Slice []T, Tol Tolerance) []T {
 	if len(Slice) == 0 {
-		return true
+		return []T{}
 	}
-	for i, value := range Slice {
-		for j := i; j < len(Slice); j++ {
-			if i != j {
-				if value == Slice[j] {
-					return false
-				}
-			}
+	if Tol.Rel >= 1 {
+		return uniqueSliceQuadratic(Slice, Tol)
+	}
+	return uniqueSliceSorted(Slice, Tol)
+}
+
+// UniqueSliceFloat returns a slice with unique elements of a slice of any float type.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// The elements keep the order of their first occurrence in the slice.
+// If the slice has no elements, the function returns an empty slice.
+func UniqueSliceFloat[T Float](Slice []T, Epsilon float64) []T {
+	return UniqueSliceTol(Slice, AbsTolerance(Epsilon))
+}
+
+// IsUniqueMap checks if all values of a map of any comparable key and value types are unique (so the map does not contain duplicated elements).
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMap[K comparable, V comparable](Map map[K]V) bool {
+	seen := make(map[V]struct{}, len(Map))
+	for _, value := range Map {
+		if _, ok := seen[value]; ok {
+			return false
 		}
+		seen[value] = struct{}{}
 	}
 	return true
 }
 
-// IsUniqueStringSlice checks if all elements in a string slice are unique (so the slice does not contain duplicated elements).
-// If the slice has no elements, the function returns true (since it does not contain duplicated elements).
-func IsUniqueStringSlice(Slice []string) bool {
-	if len(Slice) == 0 {
-		return true
+// IsUniqueMapTol checks if all values of a map of any comparable key type and any float value type are unique
+// (so the map does not contain duplicated elements).
+// The Tol parameter sets the tolerance of the comparison of two floats (see Tolerance).
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+// See IsUniqueSliceTol for how duplicates are found.
+func IsUniqueMapTol[K comparable, V Float](Map map[K]V, Tol Tolerance) bool {
+	values := make([]V, 0, len(Map))
+	for _, value := range Map {
+		values = append(values, value)
+	}
+	return IsUniqueSliceTol(values, Tol)
+}
+
+// IsUniqueMapFloat checks if all values of a map of any comparable key type and any float value type are unique
+// (so the map does not contain duplicated elements).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
+func IsUniqueMapFloat[K comparable, V Float](Map map[K]V, Epsilon float64) bool {
+	return IsUniqueMapTol(Map, AbsTolerance(Epsilon))
+}
+
+// areDuplicates checks if two floats are duplicates, that is, if either of them is within the tolerance of the other.
+func areDuplicates[T Float](X, Y T, Tol Tolerance) bool {
+	return isWithinTolerance(X, Y, Tol) || isWithinTolerance(Y, X, Tol)
+}
+
+// uniqueSliceQuadratic compares each value of a float slice with all the values kept so far.
+func uniqueSliceQuadratic[T Float](Slice []T, Tol Tolerance) []T {
+	unique := make([]T, 0, len(Slice))
+	for _, value := range Slice {
+		duplicated := false
+		for _, kept := range unique {
+			if areDuplicates(value, kept, Tol) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			unique = append(unique, value)
+		}
 	}
+	return unique
+}
+
+// uniqueSliceSorted returns the same values as uniqueSliceQuadratic, in O(n log n) time.
+// For relative tolerances below 1, the duplicates of a value form a contiguous range in the sorted slice,
+// so the function finds this range with binary search and checks whether any value kept so far lies in it.
+// The only exception are signed zeros: with Policy.SignedZeros, the range is found as if they were equal,
+// and the zeros of the opposite sign are then excluded from it.
+func uniqueSliceSorted[T Float](Slice []T, Tol Tolerance) []T {
+	n := len(Slice)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return lessFloat(Slice[order[i]], Slice[order[j]]) })
+	positions := make([]int, n)
+	sorted := make([]T, n)
+	for position, i := range order {
+		positions[i] = position
+		sorted[position] = Slice[i]
+	}
+
+	rangeTol := Tol
+	rangeTol.Policy.SignedZeros = false
+	negativeZeros := sort.Search(n, func(k int) bool { return !lessFloat(sorted[k], T(math.Copysign(0, -1))) })
+	positiveZeros := sort.Search(n, func(k int) bool { return !lessFloat(sorted[k], 0) })
+	zerosEnd := sort.Search(n, func(k int) bool { return lessFloat(T(0), sorted[k]) })
+
+	kept := make(fenwickTree, n+1)
+	unique := make([]T, 0, n)
 	for i, value := range Slice {
-		for j := i; j < len(Slice); j++ {
-			if i != j {
-				if value == Slice[j] {
-					return false
-				}
+		position := positions[i]
+		from := sort.Search(position, func(k int) bool { return areDuplicates(value, sorted[k], rangeTol) })
+		to := position + 1 + sort.Search(n-position-1, func(k int) bool {
+			return !areDuplicates(value, sorted[position+1+k], rangeTol)
+		})
+		count := kept.sum(from, to)
+		if Tol.Policy.SignedZeros && value == 0 {
+			oppositeFrom, oppositeTo := negativeZeros, positiveZeros
+			if math.Signbit(float64(value)) {
+				oppositeFrom, oppositeTo = positiveZeros, zerosEnd
+			}
+			if oppositeFrom < from {
+				oppositeFrom = from
 			}
+			if oppositeTo > to {
+				oppositeTo = to
+			}
+			count -= kept.sum(oppositeFrom, oppositeTo)
+		}
+		if count == 0 {
+			kept.add(position)
+			unique = append(unique, value)
 		}
 	}
-	return true
+	return unique
+}
+
+// fenwickTree counts marked positions, allowing one to mark a position and count marked positions in a range in O(log n) time.
+// Its length is the number of positions plus one.
+type fenwickTree []int
+
+// add marks a position.
+func (f fenwickTree) add(Position int) {
+	for i := Position + 1; i < len(f); i += i & -i {
+		f[i]++
+	}
+}
+
+// sum counts marked positions from From (inclusive) to To (exclusive).
+func (f fenwickTree) sum(From, To int) int {
+	if From >= To {
+		return 0
+	}
+	return f.prefix(To) - f.prefix(From)
+}
+
+// prefix counts marked positions before To.
+func (f fenwickTree) prefix(To int) int {
+	count := 0
+	for i := To; i > 0; i -= i & -i {
+		count += f[i]
+	}
+	return count
+}
+
+// IsUniqueFloat64Slice checks if all elements of a float64 slice are unique (so the slice does not contain duplicated elements).
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+// If the slice has no elements, the function returns true (since it does not contain duplicated elements).
+func IsUniqueFloat64Slice(Slice []float64, Epsilon float64) bool {
+	return IsUniqueSliceFloat(Slice, Epsilon)
+}
+
+// IsUniqueIntSlice checks if all elements of an int slice are unique (so the slice does not contain duplicated elements).
+// If the slice has no elements, the function returns true (since it does not contain duplicated elements).
+func IsUniqueIntSlice(Slice []int) bool {
+	return IsUniqueSlice(Slice)
+}
+
+// IsUniqueStringSlice checks if all elements in a string slice are unique (so the slice does not contain duplicated elements).
+// If the slice has no elements, the function returns true (since it does not contain duplicated elements).
+func IsUniqueStringSlice(Slice []string) bool {
+	return IsUniqueSlice(Slice)
 }
 
 // IsUnique checks whether a slice ([]int, []string and []float64) is unique (so it does not contain duplicated elements).
 // Note that if you're using it for a float slice, the comparisons are made with Epsilon set to 0.
 // If you need to use a different level of accuracy, use IsUniqueFloat64 instead.
+// For other types, the function panics; use IsUniqueSlice or IsUniqueSliceFloat instead, which work with slices of any comparable type.
 func IsUnique(Slice interface{}) bool {
 	if SliceInt, ok := Slice.([]int); ok {
 		return IsUniqueIntSlice(SliceInt)
@@ -76,158 +261,56 @@ func IsUnique(Slice interface{}) bool {
 // UniqueStringSlice returns a slice with unique elements of a string slice.
 // If the slice has no elements, the function returns an empty slice.
 func UniqueStringSlice(Slice []string) []string {
-	if len(Slice) == 0 {
-		return []string{}
-	}
-	unique := make([]string, 1)
-	unique[0] = Slice[0]
-	for _, str := range Slice {
-		if !IsValueInStringSlice(str, unique) {
-			unique = append(unique, str)
-		}
-	}
-	return unique
+	return UniqueSlice(Slice)
 }
 
 // UniqueIntSlice returns a slice with unique elements of an int slice.
 // If the slice has no elements, the function returns an empty slice.
 func UniqueIntSlice(Slice []int) []int {
-	if len(Slice) == 0 {
-		return []int{}
-	}
-	unique := make([]int, 1)
-	unique[0] = Slice[0]
-	for _, value := range Slice {
-		if !IsValueInIntSlice(value, unique) {
-			unique = append(unique, value)
-		}
-	}
-	return unique
+	return UniqueSlice(Slice)
 }
 
 // UniqueFloat64Slice returns a slice with unique elements of a float64 slice.
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // If the slice has no elements, the function returns an empty slice.
 func UniqueFloat64Slice(Slice []float64, Epsilon float64) []float64 {
-	if len(Slice) == 0 {
-		return []float64{}
-	}
-	unique := make([]float64, 1)
-	unique[0] = Slice[0]
-	for _, value := range Slice {
-		if !IsValueInFloat64Slice(value, unique, Epsilon) {
-			unique = append(unique, value)
-		}
-	}
-	return unique
+	return UniqueSliceFloat(Slice, Epsilon)
 }
 
 // IsUniqueMapIntFloat64 checks if all values of map[int]float64 are unique (so the map does not contain duplicated elements).
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
 func IsUniqueMapIntFloat64(Map map[int]float64, Epsilon float64) bool {
-	if len(Map) == 0 {
-		return true
-	}
-	for key1, value := range Map {
-		for key2, otherValue := range Map {
-			if key1 != key2 {
-				if math.Abs(value-otherValue) <= Epsilon {
-					return false
-				}
-			}
-		}
-	}
-	return true
+	return IsUniqueMapFloat(Map, Epsilon)
 }
 
 // IsUniqueMapStringFloat64 checks if all values of map[string]float64 are unique (so the map does not contain duplicated elements).
 // The Epsilon parameter sets the accuracy of the comparison of two floats.
 // Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
 func IsUniqueMapStringFloat64(Map map[string]float64, Epsilon float64) bool {
-	if len(Map) == 0 {
-		return true
-	}
-	for key1, value := range Map {
-		for key2, otherValue := range Map {
-			if key1 != key2 {
-				if math.Abs(value-otherValue) <= Epsilon {
-					return false
-				}
-			}
-		}
-	}
-	return true
+	return IsUniqueMapFloat(Map, Epsilon)
 }
 
 // IsUniqueMapIntInt checks if all values of map[int]int are unique (so the map does not contain duplicated elements).
 // Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
 func IsUniqueMapIntInt(Map map[int]int) bool {
-	if len(Map) == 0 {
-		return true
-	}
-	for key1, value := range Map {
-		for key2, otherValue := range Map {
-			if key1 != key2 {
-				if value == otherValue {
-					return false
-				}
-			}
-		}
-	}
-	return true
+	return IsUniqueMap(Map)
 }
 
 // IsUniqueMapStringInt checks if all values of map[string]int are unique (so the map does not contain duplicated elements).
 // Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
 func IsUniqueMapStringInt(Map map[string]int) bool {
-	if len(Map) == 0 {
-		return true
-	}
-	for key1, value := range Map {
-		for key2, otherValue := range Map {
-			if key1 != key2 {
-				if value == otherValue {
-					return false
-				}
-			}
-		}
-	}
-	return true
+	return IsUniqueMap(Map)
 }
 
 // IsUniqueMapIntString checks if all values of map[int]string are unique (so the map does not contain duplicated elements).
 // Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
 func IsUniqueMapIntString(Map map[int]string) bool {
-	if len(Map) == 0 {
-		return true
-	}
-	for key1, value := range Map {
-		for key2, otherValue := range Map {
-			if key1 != key2 {
-				if value == otherValue {
-					return false
-				}
-			}
-		}
-	}
-	return true
+	return IsUniqueMap(Map)
 }
 
 // IsUniqueMapStringString checks if all values of map[int]string are unique (so the map does not contain duplicated elements).
 // Note that uniqueness here means the equality of values, since a map will never be unique in terms of key-value pairs.
 func IsUniqueMapStringString(Map map[string]string) bool {
-	if len(Map) == 0 {
-		return true
-	}
-	for key1, value := range Map {
-		for key2, otherValue := range Map {
-			if key1 != key2 {
-				if value == otherValue {
-					return false
-				}
-			}
-		}
-	}
-	return true
+	return IsUniqueMap(Map)
 }
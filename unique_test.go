@@ -2,6 +2,8 @@ package check
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -495,3 +497,235 @@ func TestIsUniquePanics4(t *testing.T) {
 	IsUnique(incorrectSlice)
 	t.Errorf("IsUnique did not panic for %v", incorrectSlice)
 }
+
+func TestIsUniqueSlice(t *testing.T) {
+	tests := []struct {
+		slice    []int32
+		expected bool
+	}{
+		{[]int32{}, true},
+		{[]int32{1}, true},
+		{[]int32{1, 2, 3}, true},
+		{[]int32{1, 2, 1}, false},
+	}
+	for _, test := range tests {
+		if IsUniqueSlice(test.slice) != test.expected {
+			t.Errorf("IsUniqueSlice(%v) should be %v", test.slice, test.expected)
+		}
+	}
+}
+
+func TestIsUniqueSliceFloat(t *testing.T) {
+	tests := []struct {
+		slice    []float32
+		epsilon  float64
+		expected bool
+	}{
+		{[]float32{}, 0, true},
+		{[]float32{1, 1.5}, 0, true},
+		{[]float32{1, 1.5}, .5, false},
+	}
+	for _, test := range tests {
+		if IsUniqueSliceFloat(test.slice, test.epsilon) != test.expected {
+			t.Errorf("IsUniqueSliceFloat(%v, %v) should be %v", test.slice, test.epsilon, test.expected)
+		}
+	}
+}
+
+func TestUniqueSlice(t *testing.T) {
+	tests := []struct {
+		slice    []bool
+		expected []bool
+	}{
+		{[]bool{}, []bool{}},
+		{[]bool{true, true}, []bool{true}},
+		{[]bool{false, true, false, true}, []bool{false, true}},
+	}
+	for _, test := range tests {
+		if actual := UniqueSlice(test.slice); !AreEqualSlices(actual, test.expected) {
+			t.Errorf("UniqueSlice(%v) = %v; want %v", test.slice, actual, test.expected)
+		}
+	}
+}
+
+func TestUniqueSliceFloat(t *testing.T) {
+	tests := []struct {
+		slice    []float32
+		epsilon  float64
+		expected []float32
+	}{
+		{[]float32{}, 0, []float32{}},
+		{[]float32{1, 1.5, 1}, 0, []float32{1, 1.5}},
+		{[]float32{1, 1.5, 1}, .5, []float32{1}},
+	}
+	for _, test := range tests {
+		if actual := UniqueSliceFloat(test.slice, test.epsilon); !AreEqualSlices(actual, test.expected) {
+			t.Errorf("UniqueSliceFloat(%v, %v) = %v; want %v", test.slice, test.epsilon, actual, test.expected)
+		}
+	}
+}
+
+func TestIsUniqueMap(t *testing.T) {
+	tests := []struct {
+		Map      map[string]bool
+		expected bool
+	}{
+		{map[string]bool{}, true},
+		{map[string]bool{"a": true, "b": false}, true},
+		{map[string]bool{"a": true, "b": false, "c": true}, false},
+	}
+	for _, test := range tests {
+		if IsUniqueMap(test.Map) != test.expected {
+			t.Errorf("IsUniqueMap(%v) should be %v", test.Map, test.expected)
+		}
+	}
+}
+
+func TestIsUniqueMapFloat(t *testing.T) {
+	tests := []struct {
+		Map      map[int64]float32
+		epsilon  float64
+		expected bool
+	}{
+		{map[int64]float32{}, 0, true},
+		{map[int64]float32{1: 1, 2: 1.5}, 0, true},
+		{map[int64]float32{1: 1, 2: 1.5}, .5, false},
+	}
+	for _, test := range tests {
+		if IsUniqueMapFloat(test.Map, test.epsilon) != test.expected {
+			t.Errorf("IsUniqueMapFloat(%v, %v) should be %v", test.Map, test.epsilon, test.expected)
+		}
+	}
+}
+
+func ExampleUniqueSlice() {
+	fmt.Println(UniqueSlice([]uint{3, 1, 3, 2, 1}))
+	// Output:
+	// [3 1 2]
+}
+
+func TestUniqueSliceFloatKeepsFirstOccurrences(t *testing.T) {
+	tests := []struct {
+		slice    []float64
+		epsilon  float64
+		expected []float64
+	}{
+		{[]float64{3, 1, 3.05, 2, 1.02, 0.5}, .1, []float64{3, 1, 2, 0.5}},
+		{[]float64{2, 2.08, 2.16, 2.24}, .1, []float64{2, 2.16}},
+		{[]float64{2.24, 2.16, 2.08, 2}, .1, []float64{2.24, 2.08}},
+		{[]float64{2.08, 2, 2.16}, .1, []float64{2.08}},
+		{[]float64{5, 4, 3, 2, 1}, 0, []float64{5, 4, 3, 2, 1}},
+	}
+	for _, test := range tests {
+		if !AreEqualSlicesFloat(UniqueSliceFloat(test.slice, test.epsilon), test.expected, 0) {
+			t.Errorf("UniqueSliceFloat(%v, %v) should be %v", test.slice, test.epsilon, test.expected)
+		}
+	}
+}
+
+func TestUniqueSliceTolSpecialValues(t *testing.T) {
+	nan, inf, negZero := math.NaN(), math.Inf(1), math.Copysign(0, -1)
+	tests := []struct {
+		slice    []float64
+		tol      Tolerance
+		expected int
+	}{
+		{[]float64{nan, 1, nan}, AbsTolerance(.1), 3},
+		{[]float64{nan, 1, nan}, AbsTolerance(.1).WithPolicy(MissingDataPolicy), 2},
+		{[]float64{inf, 1, inf, -inf}, AbsTolerance(.1), 4},
+		{[]float64{inf, 1, inf, -inf}, AbsTolerance(.1).WithPolicy(MissingDataPolicy), 3},
+		{[]float64{0, negZero}, AbsTolerance(0), 1},
+		{[]float64{0, negZero}, AbsTolerance(0).WithPolicy(FloatPolicy{SignedZeros: true}), 2},
+		{[]float64{-1e-300, 0, negZero}, AbsTolerance(1e-200).WithPolicy(FloatPolicy{SignedZeros: true}), 1},
+		{[]float64{0, negZero, -1e-300}, AbsTolerance(1e-200).WithPolicy(FloatPolicy{SignedZeros: true}), 2},
+	}
+	for _, test := range tests {
+		got := UniqueSliceTol(test.slice, test.tol)
+		if len(got) != test.expected {
+			t.Errorf("UniqueSliceTol(%v, %v) should have %v elements, got %v", test.slice, test.tol, test.expected, got)
+		}
+		if IsUniqueSliceTol(test.slice, test.tol) != (test.expected == len(test.slice)) {
+			t.Errorf("IsUniqueSliceTol(%v, %v) should be %v", test.slice, test.tol, test.expected == len(test.slice))
+		}
+	}
+}
+
+func TestUniqueSliceTolMatchesPairwiseComparison(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	values := []float64{math.NaN(), math.Inf(1), math.Inf(-1), 0, math.Copysign(0, -1), 1e-300, -1e-300}
+	tolerances := []Tolerance{
+		AbsTolerance(0),
+		AbsTolerance(.5),
+		RelTolerance(.2),
+		RelTolerance(.9),
+		RelTolerance(1),
+		CombinedTolerance(.3, .1),
+		ULPTolerance(1 << 50),
+		AbsTolerance(.5).WithPolicy(MissingDataPolicy),
+		AbsTolerance(.5).WithPolicy(FloatPolicy{SignedZeros: true}),
+	}
+	for round := 0; round < 2000; round++ {
+		slice := make([]float64, random.Intn(20))
+		for i := range slice {
+			if random.Intn(4) == 0 {
+				slice[i] = values[random.Intn(len(values))]
+			} else {
+				slice[i] = math.Round((random.Float64()*20-10)*10) / 10
+			}
+		}
+		for _, tol := range tolerances {
+			expected := uniqueSliceQuadratic(slice, tol)
+			got := UniqueSliceTol(slice, tol)
+			if !AreEqualSlicesTol(got, expected, Tolerance{}.WithPolicy(FloatPolicy{NaNEqualsNaN: true, InfEqualsInf: true, SignedZeros: true})) {
+				t.Fatalf("UniqueSliceTol(%v, %v) should be %v, got %v", slice, tol, expected, got)
+			}
+			if IsUniqueSliceTol(slice, tol) != (len(expected) == len(slice)) {
+				t.Fatalf("IsUniqueSliceTol(%v, %v) should be %v", slice, tol, len(expected) == len(slice))
+			}
+		}
+	}
+}
+
+func TestUniqueSliceKeepsNaNs(t *testing.T) {
+	slice := []float64{math.NaN(), 1, math.NaN(), 1}
+	if got := UniqueSlice(slice); len(got) != 3 {
+		t.Errorf("UniqueSlice(%v) should have 3 elements, got %v", slice, got)
+	}
+	if IsUniqueSlice([]float64{math.NaN(), math.NaN()}) != true {
+		t.Errorf("IsUniqueSlice([NaN NaN]) should be true")
+	}
+}
+
+func benchmarkUniqueFloat64Slice(n int, b *testing.B) {
+	xx := make([]float64, n)
+	for i := range xx {
+		xx[i] = float64(i%(n/2+1)) / 3
+	}
+	for i := 0; i < b.N; i++ {
+		UniqueFloat64Slice(xx, .01)
+	}
+}
+
+func BenchmarkUniqueFloat64Slice100(b *testing.B) {
+	benchmarkUniqueFloat64Slice(100, b)
+}
+func BenchmarkUniqueFloat64Slice10000(b *testing.B) {
+	benchmarkUniqueFloat64Slice(10000, b)
+}
+
+func benchmarkUniqueIntSlice(n int, b *testing.B) {
+	xx := make([]int, n)
+	for i := range xx {
+		xx[i] = i % (n/2 + 1)
+	}
+	for i := 0; i < b.N; i++ {
+		UniqueIntSlice(xx)
+	}
+}
+
+func BenchmarkUniqueIntSlice100(b *testing.B) {
+	benchmarkUniqueIntSlice(100, b)
+}
+func BenchmarkUniqueIntSlice10000(b *testing.B) {
+	benchmarkUniqueIntSlice(10000, b)
+}
@@ -0,0 +1,277 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FieldError is a failure collected by a Validator: the path of the field that failed a check, and why.
+// Paths are field names given to That, ThatFloat, ThatSlice and ThatMap, with indices for slice elements
+// (as in "tags[2]") and keys for map entries (as in "cfg.env").
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error returns the field path and the message, e.g., "region: eu-north is not one of [eu-west us-east]".
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Validation is anything that collects failures, like the validators returned by That, ThatSlice and ThatMap;
+// it can be added to a Validator with And.
+type Validation interface {
+	Failures() []*FieldError
+}
+
+// Validator collects the failures of fluently chained checks, which call the package's functions
+// (like IsValueIn, AllKeyValuePairsInMap and IsUniqueSlice) under the hood. All checks are run,
+// so that Validate can report all failures at once:
+//
+//	err := check.That("region", region).IsIn(allowed).
+//		And(check.ThatMap("cfg", cfg).HasKeyValuePairs(required)).
+//		Validate()
+type Validator struct {
+	failures []*FieldError
+	others   []Validation
+}
+
+// fail records a failure.
+func (v *Validator) fail(Field, Format string, Args ...any) {
+	v.failures = append(v.failures, &FieldError{Field: Field, Message: fmt.Sprintf(Format, Args...)})
+}
+
+// And adds other validations to the validator. Their failures are collected when Failures or Validate is called,
+// so checks chained on them after And are not lost.
+func (v *Validator) And(Others ...Validation) *Validator {
+	v.others = append(v.others, Others...)
+	return v
+}
+
+// Failures returns the failures of the validator's own checks, in the order of the checks,
+// followed by the failures of the validations added with And, in the order they were added.
+// Each validator's failures are collected once, so adding a validator more than once, or to itself
+// (directly or through others), does not duplicate its failures.
+func (v *Validator) Failures() []*FieldError {
+	return v.collect(map[*Validator]bool{})
+}
+
+// validator returns the Validator itself; validators that embed it use it to expose it to collect.
+func (v *Validator) validator() *Validator {
+	return v
+}
+
+// collect returns the failures of the validator and of the validations added with And,
+// skipping the validators already visited.
+func (v *Validator) collect(Visited map[*Validator]bool) []*FieldError {
+	if Visited[v] {
+		return nil
+	}
+	Visited[v] = true
+	failures := make([]*FieldError, len(v.failures))
+	copy(failures, v.failures)
+	for _, other := range v.others {
+		if o, ok := other.(interface{ validator() *Validator }); ok {
+			failures = append(failures, o.validator().collect(Visited)...)
+		} else {
+			failures = append(failures, other.Failures()...)
+		}
+	}
+	return failures
+}
+
+// Validate returns nil if all checks passed. Otherwise, it returns the failures (of type *FieldError),
+// in the order of Failures, joined with errors.Join, so that errors.As can find them.
+func (v *Validator) Validate() error {
+	failures := v.Failures()
+	errs := make([]error, len(failures))
+	for i, failure := range failures {
+		errs[i] = failure
+	}
+	return errors.Join(errs...)
+}
+
+// ValueValidator checks a single value of any comparable type; create it with That.
+type ValueValidator[T comparable] struct {
+	*Validator
+	field string
+	value T
+}
+
+// That starts the validation of a value of any comparable type, reported under the Field path.
+func That[T comparable](Field string, Value T) *ValueValidator[T] {
+	return &ValueValidator[T]{Validator: &Validator{}, field: Field, value: Value}
+}
+
+// IsIn checks if the value is one of the allowed values; see IsValueIn.
+func (v *ValueValidator[T]) IsIn(Allowed []T) *ValueValidator[T] {
+	if !IsValueIn(v.value, Allowed) {
+		v.fail(v.field, "%v is not one of %v", v.value, Allowed)
+	}
+	return v
+}
+
+// IsNotIn checks if the value is none of the forbidden values; see IsValueIn.
+func (v *ValueValidator[T]) IsNotIn(Forbidden []T) *ValueValidator[T] {
+	if IsValueIn(v.value, Forbidden) {
+		v.fail(v.field, "%v is forbidden", v.value)
+	}
+	return v
+}
+
+// FloatValidator checks a single float value; create it with ThatFloat.
+type FloatValidator[T Float] struct {
+	*Validator
+	field string
+	value T
+}
+
+// ThatFloat starts the validation of a value of any float type, reported under the Field path.
+func ThatFloat[T Float](Field string, Value T) *FloatValidator[T] {
+	return &FloatValidator[T]{Validator: &Validator{}, field: Field, value: Value}
+}
+
+// IsIn checks if the value is one of the allowed values; see IsValueInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func (v *FloatValidator[T]) IsIn(Allowed []T, Epsilon float64) *FloatValidator[T] {
+	if !IsValueInFloat(v.value, Allowed, Epsilon) {
+		v.fail(v.field, "%v is not one of %v", v.value, Allowed)
+	}
+	return v
+}
+
+// IsNotIn checks if the value is none of the forbidden values; see IsValueInFloat.
+// The Epsilon parameter sets the accuracy of the comparison of two floats.
+func (v *FloatValidator[T]) IsNotIn(Forbidden []T, Epsilon float64) *FloatValidator[T] {
+	if IsValueInFloat(v.value, Forbidden, Epsilon) {
+		v.fail(v.field, "%v is forbidden", v.value)
+	}
+	return v
+}
+
+// SliceValidator checks a slice of any comparable type; create it with ThatSlice.
+type SliceValidator[T comparable] struct {
+	*Validator
+	field string
+	slice []T
+}
+
+// ThatSlice starts the validation of a slice of any comparable type, reported under the Field path;
+// failures of single elements are reported with their indices, as in "tags[2]".
+func ThatSlice[T comparable](Field string, Slice []T) *SliceValidator[T] {
+	return &SliceValidator[T]{Validator: &Validator{}, field: Field, slice: Slice}
+}
+
+// element returns the path of an element of the slice.
+func (v *SliceValidator[T]) element(Index int) string {
+	return fmt.Sprintf("%v[%v]", v.field, Index)
+}
+
+// IsUnique checks if the slice has no duplicated values; see IsUniqueSlice.
+// Each duplicate is reported at its index.
+func (v *SliceValidator[T]) IsUnique() *SliceValidator[T] {
+	if IsUniqueSlice(v.slice) {
+		return v
+	}
+	first := make(map[T]int, len(v.slice))
+	for i, value := range v.slice {
+		if j, ok := first[value]; ok {
+			v.fail(v.element(i), "%v duplicates %v", value, v.element(j))
+		} else {
+			first[value] = i
+		}
+	}
+	return v
+}
+
+// AllIn checks if all values of the slice are allowed values; see AllValuesIn.
+// Each value that is not allowed is reported at its index.
+func (v *SliceValidator[T]) AllIn(Allowed []T) *SliceValidator[T] {
+	if len(v.slice) == 0 || AllValuesIn(v.slice, Allowed) {
+		return v
+	}
+	for i, value := range v.slice {
+		if !IsValueIn(value, Allowed) {
+			v.fail(v.element(i), "%v is not one of %v", value, Allowed)
+		}
+	}
+	return v
+}
+
+// Contains checks if all required values are in the slice; see AllValuesIn.
+func (v *SliceValidator[T]) Contains(Required []T) *SliceValidator[T] {
+	if len(Required) == 0 || AllValuesIn(Required, v.slice) {
+		return v
+	}
+	for _, value := range Required {
+		if !IsValueIn(value, v.slice) {
+			v.fail(v.field, "%v is missing", value)
+		}
+	}
+	return v
+}
+
+// MapValidator checks a map of any ordered key type and any comparable value type; create it with ThatMap.
+type MapValidator[K Ordered, V comparable] struct {
+	*Validator
+	field string
+	m     map[K]V
+}
+
+// ThatMap starts the validation of a map of any ordered key type and any comparable value type,
+// reported under the Field path; failures of single entries are reported with their keys, as in "cfg.env",
+// in increasing order of the keys.
+func ThatMap[K Ordered, V comparable](Field string, Map map[K]V) *MapValidator[K, V] {
+	return &MapValidator[K, V]{Validator: &Validator{}, field: Field, m: Map}
+}
+
+// entry returns the path of an entry of the map.
+func (v *MapValidator[K, V]) entry(Key K) string {
+	return fmt.Sprintf("%v.%v", v.field, Key)
+}
+
+// HasKeyValuePairs checks if the map has all the required key-value pairs; see AllKeyValuePairsInMap.
+// Each missing or different pair is reported under its key.
+func (v *MapValidator[K, V]) HasKeyValuePairs(Required map[K]V) *MapValidator[K, V] {
+	if len(Required) == 0 || AllKeyValuePairsInMap(Required, v.m) {
+		return v
+	}
+	mismatches, _ := WhichKeyValuePairsNotInMap(Required, v.m)
+	for _, key := range SortedKeys(mismatches) {
+		mismatch := mismatches[key]
+		if mismatch.KeyAbsent {
+			v.fail(v.entry(key), "is missing, want %v", mismatch.Value)
+		} else {
+			v.fail(v.entry(key), "is %v, want %v", mismatch.OtherValue, mismatch.Value)
+		}
+	}
+	return v
+}
+
+// HasKeys checks if the map has all the required keys; see DoKeysContainSlice.
+// Each missing key is reported at its path.
+func (v *MapValidator[K, V]) HasKeys(Required []K) *MapValidator[K, V] {
+	missing, _ := WhichKeysMissing(v.m, Required)
+	for _, key := range sortKeys(missing) {
+		v.fail(v.entry(key), "is missing")
+	}
+	return v
+}
+
+// HasUniqueValues checks if the map has no duplicated values; see IsUniqueMap.
+// Each key whose value duplicates the value of a smaller key is reported.
+func (v *MapValidator[K, V]) HasUniqueValues() *MapValidator[K, V] {
+	if IsUniqueMap(v.m) {
+		return v
+	}
+	first := make(map[V]K, len(v.m))
+	for _, key := range SortedKeys(v.m) {
+		value := v.m[key]
+		if other, ok := first[value]; ok {
+			v.fail(v.entry(key), "%v duplicates %v", value, v.entry(other))
+		} else {
+			first[value] = key
+		}
+	}
+	return v
+}
@@ -0,0 +1,170 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func failureStrings(Failures []*FieldError) []string {
+	got := make([]string, len(Failures))
+	for i, failure := range Failures {
+		got[i] = failure.Error()
+	}
+	return got
+}
+
+func TestThat(t *testing.T) {
+	allowed := []string{"eu-west", "us-east"}
+	testCases := []struct {
+		value string
+		want  []string
+	}{
+		{"eu-west", []string{}},
+		{"eu-north", []string{"region: eu-north is not one of [eu-west us-east]"}},
+	}
+	for _, testCase := range testCases {
+		got := failureStrings(That("region", testCase.value).IsIn(allowed).Failures())
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("That(region, %v).IsIn(%v) = %v; want %v", testCase.value, allowed, got, testCase.want)
+		}
+	}
+
+	got := failureStrings(That("port", 22).IsNotIn([]int{22, 23}).IsIn([]int{80, 443}).Failures())
+	want := []string{"port: 22 is forbidden", "port: 22 is not one of [80 443]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("That(port, 22) = %v; want %v", got, want)
+	}
+}
+
+func TestThatFloat(t *testing.T) {
+	testCases := []struct {
+		value float64
+		want  []string
+	}{
+		{.501, []string{}},
+		{.6, []string{"ratio: 0.6 is not one of [0.25 0.5]"}},
+	}
+	for _, testCase := range testCases {
+		got := failureStrings(ThatFloat("ratio", testCase.value).IsIn([]float64{.25, .5}, .01).Failures())
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("ThatFloat(ratio, %v).IsIn = %v; want %v", testCase.value, got, testCase.want)
+		}
+	}
+	if got := failureStrings(ThatFloat("ratio", .501).IsNotIn([]float64{.5}, .01).Failures()); len(got) != 1 {
+		t.Errorf("ThatFloat(ratio, .501).IsNotIn([.5]) = %v; want one failure", got)
+	}
+}
+
+func TestThatSlice(t *testing.T) {
+	testCases := []struct {
+		slice []string
+		want  []string
+	}{
+		{[]string{}, []string{}},
+		{[]string{"a", "b"}, []string{}},
+		{[]string{"a", "b", "a", "a"}, []string{"tags[2]: a duplicates tags[0]", "tags[3]: a duplicates tags[0]"}},
+	}
+	for _, testCase := range testCases {
+		got := failureStrings(ThatSlice("tags", testCase.slice).IsUnique().Failures())
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("ThatSlice(tags, %v).IsUnique() = %v; want %v", testCase.slice, got, testCase.want)
+		}
+	}
+
+	got := failureStrings(ThatSlice("tags", []string{"a", "x", "b"}).AllIn([]string{"a", "b"}).Contains([]string{"b", "c"}).Failures())
+	want := []string{"tags[1]: x is not one of [a b]", "tags: c is missing"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ThatSlice(tags).AllIn().Contains() = %v; want %v", got, want)
+	}
+}
+
+func TestThatMap(t *testing.T) {
+	cfg := map[string]string{"env": "dev", "owner": "ops", "team": "ops"}
+	testCases := []struct {
+		required map[string]string
+		want     []string
+	}{
+		{map[string]string{}, []string{}},
+		{map[string]string{"env": "dev"}, []string{}},
+		{map[string]string{"tier": "web", "env": "prod"}, []string{"cfg.env: is dev, want prod", "cfg.tier: is missing, want web"}},
+	}
+	for _, testCase := range testCases {
+		got := failureStrings(ThatMap("cfg", cfg).HasKeyValuePairs(testCase.required).Failures())
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("ThatMap(%v).HasKeyValuePairs(%v) = %v; want %v", cfg, testCase.required, got, testCase.want)
+		}
+	}
+
+	got := failureStrings(ThatMap("cfg", cfg).HasKeys([]string{"zone", "env", "app"}).HasUniqueValues().Failures())
+	want := []string{"cfg.app: is missing", "cfg.zone: is missing", "cfg.team: ops duplicates cfg.owner"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ThatMap(%v).HasKeys().HasUniqueValues() = %v; want %v", cfg, got, want)
+	}
+}
+
+func TestValidatorValidate(t *testing.T) {
+	if err := That("region", "eu-west").IsIn([]string{"eu-west"}).And(ThatMap("ids", map[int]int{1: 1}).HasKeys([]int{1})).Validate(); err != nil {
+		t.Errorf("Validate() = %v; want nil", err)
+	}
+
+	err := That("region", "eu-north").IsIn([]string{"eu-west"}).
+		And(ThatMap("ids", map[int]int{}).HasKeys([]int{7})).
+		Validate()
+	want := "region: eu-north is not one of [eu-west]\nids.7: is missing"
+	if err == nil || err.Error() != want {
+		t.Fatalf("Validate() = %v; want %v", err, want)
+	}
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "region" {
+		t.Errorf("errors.As(Validate(), *FieldError) = %v; want the region failure", fieldErr)
+	}
+}
+
+func TestValidatorAndCollectsLaterChecks(t *testing.T) {
+	tags := ThatSlice("tags", []string{"a", "a"})
+	v := That("region", "eu-west").IsIn([]string{"eu-west"}).And(tags)
+	tags.IsUnique()
+	if got, want := failureStrings(v.Failures()), []string{"tags[1]: a duplicates tags[0]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Failures() = %v; want %v", got, want)
+	}
+}
+
+func TestValidatorAndCycle(t *testing.T) {
+	a := That("a", 1).IsIn([]int{2})
+	b := ThatSlice("b", []int{1, 1}).IsUnique()
+	a.And(b)
+	b.And(a, b)
+	want := []string{"a: 1 is not one of [2]", "b[1]: 1 duplicates b[0]"}
+	if got := failureStrings(a.Failures()); !reflect.DeepEqual(got, want) {
+		t.Errorf("a.Failures() with a cycle = %v; want %v", got, want)
+	}
+	v := That("v", 1).IsIn([]int{2})
+	v.And(v)
+	if err := v.Validate(); err == nil || err.Error() != "v: 1 is not one of [2]" {
+		t.Errorf("v.And(v).Validate() = %v; want v: 1 is not one of [2]", err)
+	}
+}
+
+func TestValidatorFailuresIsCopy(t *testing.T) {
+	v := That("n", 1).IsIn([]int{2})
+	failures := v.Failures()
+	failures[0] = &FieldError{Field: "other"}
+	if got := v.Failures()[0].Field; got != "n" {
+		t.Errorf("Failures()[0].Field = %v; want n", got)
+	}
+}
+
+func ExampleThat() {
+	err := That("region", "eu-north").IsIn([]string{"eu-west", "us-east"}).
+		And(ThatSlice("tags", []string{"a", "b", "a"}).IsUnique()).
+		And(ThatMap("cfg", map[string]string{"env": "dev"}).HasKeyValuePairs(map[string]string{"env": "prod", "tier": "web"})).
+		Validate()
+	fmt.Println(err)
+	// Output:
+	// region: eu-north is not one of [eu-west us-east]
+	// tags[2]: a duplicates tags[0]
+	// cfg.env: is dev, want prod
+	// cfg.tier: is missing, want web
+}